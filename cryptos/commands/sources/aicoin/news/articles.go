@@ -65,7 +65,6 @@ func (h *ArticlesHandler) save() error {
         "articles.#.id",
       },
     },
-    "scroll": "articles.#.createtime",
   }
   extractRules := make(map[string]interface{})
 
@@ -258,6 +257,9 @@ func (h *ArticlesHandler) save() error {
 
   useProxy := true
   timeout := 10
+  method := "GET"
+  body := ""
+  contentType := ""
   r, err := h.Repository.Save(
     parentId,
     name,
@@ -268,6 +270,9 @@ func (h *ArticlesHandler) save() error {
     extractRules,
     useProxy,
     timeout,
+    method,
+    body,
+    contentType,
   )
   if err != nil {
     return err
@@ -152,6 +152,9 @@ func (h *CategoriesHandler) save() error {
 
   useProxy := true
   timeout := 10
+  method := "GET"
+  body := ""
+  contentType := ""
   r, err := h.Repository.Save(
     source.Data.Id,
     name,
@@ -162,6 +165,9 @@ func (h *CategoriesHandler) save() error {
     extractRules,
     useProxy,
     timeout,
+    method,
+    body,
+    contentType,
   )
   if err != nil {
     return err
@@ -254,6 +254,9 @@ func (h *HomeHandler) save() error {
 
   useProxy := true
   timeout := 10
+  method := "GET"
+  body := ""
+  contentType := ""
   r, err := h.Repository.Save(
     parentId,
     name,
@@ -264,6 +267,9 @@ func (h *HomeHandler) save() error {
     extractRules,
     useProxy,
     timeout,
+    method,
+    body,
+    contentType,
   )
   if err != nil {
     return err
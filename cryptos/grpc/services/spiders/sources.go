@@ -66,14 +66,20 @@ func (srv *Sources) Save(
   extractRules map[string]interface{},
   useProxy bool,
   timeout int,
+  method string,
+  body string,
+  contentType string,
 ) (*pb.SaveReply, error) {
   request := &pb.SaveRequest{
-    ParentId: parentId,
-    Name:     name,
-    Slug:     slug,
-    Url:      url,
-    UseProxy: useProxy,
-    Timeout:  uint32(timeout),
+    ParentId:    parentId,
+    Name:        name,
+    Slug:        slug,
+    Url:         url,
+    UseProxy:    useProxy,
+    Timeout:     uint32(timeout),
+    Method:      method,
+    Body:        body,
+    ContentType: contentType,
   }
 
   for name, value := range headers {
@@ -96,6 +102,29 @@ func (srv *Sources) Save(
   if value, ok := params["scroll"].(string); ok {
     request.Params.Scroll = value
   }
+  if item, ok := params["proxy"].(map[string]string); ok {
+    request.Params.Proxy = &pb.ProxyParams{
+      Pool:     item["pool"],
+      Tag:      item["tag"],
+      Rotation: item["rotation"],
+    }
+  }
+  if item, ok := params["limit"].(map[string]float64); ok {
+    request.Params.Limit = &pb.RateLimit{
+      Rate:        item["rate"],
+      Concurrency: uint32(item["concurrency"]),
+      Jitter:      uint32(item["jitter"]),
+    }
+  }
+  if value, ok := params["robots"].(bool); ok {
+    request.Params.IgnoreRobots = !value
+  }
+  if value, ok := params["timezone"].(string); ok {
+    request.Params.Timezone = value
+  }
+  if value, ok := params["charset"].(string); ok {
+    request.Params.Charset = value
+  }
   if items, ok := params["query"]; ok {
     for _, item := range items.([]map[string]string) {
       query := &pb.HttpQuery{}
@@ -119,6 +148,13 @@ func (srv *Sources) Save(
 
     data := data.(map[string]interface{})
 
+    if value, ok := data["input"]; ok {
+      rules.Input = value.(string)
+    }
+    if value, ok := data["internal"]; ok {
+      rules.Internal = value.(bool)
+    }
+
     if html, ok := data["html"]; ok {
       rules.Html = &pb.HtmlExtractRules{}
       html := html.(map[string]interface{})
@@ -134,6 +170,15 @@ func (srv *Sources) Save(
         if value, ok := container["index"]; ok {
           rules.Html.Container.Index = value.(uint32)
         }
+        if value, ok := container["type"]; ok {
+          rules.Html.Container.Type = value.(string)
+        }
+        if value, ok := container["resolve"]; ok {
+          rules.Html.Container.Resolve = value.(bool)
+        }
+        if value, ok := container["fallbacks"]; ok {
+          rules.Html.Container.Fallbacks = value.([]string)
+        }
       }
       if list, ok := html["list"]; ok {
         list := list.(map[string]interface{})
@@ -147,77 +192,72 @@ func (srv *Sources) Save(
         if value, ok := list["index"]; ok {
           rules.Html.List.Index = value.(uint32)
         }
+        if value, ok := list["type"]; ok {
+          rules.Html.List.Type = value.(string)
+        }
+        if value, ok := list["resolve"]; ok {
+          rules.Html.List.Resolve = value.(bool)
+        }
+        if value, ok := list["fallbacks"]; ok {
+          rules.Html.List.Fallbacks = value.([]string)
+        }
       }
       if fields, ok := html["fields"]; ok {
-        fields := fields.([]interface{})
-        for _, field := range fields {
-          field := field.(map[string]interface{})
-          message := &pb.HtmlExtractField{}
-          if value, ok := field["name"]; ok {
-            message.Name = value.(string)
-          }
-          if value, ok := field["node"]; ok {
-            value := value.(map[string]interface{})
-            message.Node = &pb.HtmlExtractNode{}
-            if value, ok := value["selector"]; ok {
-              message.Node.Selector = value.(string)
-            }
-            if value, ok := value["attr"]; ok {
-              message.Node.Attr = value.(string)
-            }
-            if value, ok := value["index"]; ok {
-              message.Node.Index = value.(uint32)
-            }
-          }
-          if value, ok := field["match"]; ok {
-            message.Match = value.(string)
-          }
-          if values, ok := field["regex_replace"]; ok {
-            values := values.([]map[string]string)
-            for _, value := range values {
-              replace := &pb.RegexReplace{}
-              if value, ok := value["pattern"]; ok {
-                replace.Pattern = value
-              }
-              if value, ok := value["value"]; ok {
-                replace.Value = value
-              }
-              message.RegexReplace = append(message.RegexReplace, replace)
-            }
-          }
-          if values, ok := field["text_replace"]; ok {
-            values := values.([]map[string]string)
-            for _, value := range values {
-              replace := &pb.TextReplace{}
-              if value, ok := value["text"]; ok {
-                replace.Text = value
-              }
-              if value, ok := value["value"]; ok {
-                replace.Value = value
-              }
-              message.TextReplace = append(message.TextReplace, replace)
-            }
-          }
-          rules.Html.Fields = append(rules.Html.Fields, message)
-        }
+        rules.Html.Fields = srv.ToHtmlExtractFields(fields.([]interface{}))
+      }
+      if computed, ok := html["computed"]; ok {
+        rules.Html.Computed = srv.ToComputedFields(computed.([]map[string]string))
+      }
+      if filters, ok := html["filters"]; ok {
+        rules.Html.Filters = srv.ToFilters(filters.([]interface{}))
       }
     }
 
     if json, ok := data["json"]; ok {
-      rules.Json = &pb.JsonExtractRules{}
-      json := json.(map[string]interface{})
-      if container, ok := json["container"]; ok {
-        rules.Json.Container = container.(string)
+      rules.Json = srv.ToJsonExtractRules(json.(map[string]interface{}))
+    }
+
+    if script, ok := data["script"]; ok {
+      rules.Script = &pb.ScriptExtractRules{}
+      script := script.(map[string]interface{})
+      if node, ok := script["node"]; ok {
+        node := node.(map[string]interface{})
+        rules.Script.Node = &pb.HtmlExtractNode{}
+        if value, ok := node["selector"]; ok {
+          rules.Script.Node.Selector = value.(string)
+        }
+        if value, ok := node["type"]; ok {
+          rules.Script.Node.Type = value.(string)
+        }
       }
-      if list, ok := json["list"]; ok {
-        rules.Json.List = list.(string)
+      if value, ok := script["variable"]; ok {
+        rules.Script.Variable = value.(string)
       }
-      if fields, ok := json["fields"]; ok {
-        fields := fields.([]interface{})
-        rules.Json.Fields = srv.ToJsonExtractFields(fields)
+      if json, ok := script["json"]; ok {
+        rules.Script.Json = srv.ToJsonExtractRules(json.(map[string]interface{}))
+      }
+    }
+
+    if xml, ok := data["xml"]; ok {
+      rules.Xml = srv.ToXmlExtractRules(xml.(map[string]interface{}))
+    }
+
+    if article, ok := data["article"]; ok {
+      rules.Article = srv.ToArticleExtractRules(article.(map[string]interface{}))
+    }
+
+    if metadata, ok := data["metadata"]; ok {
+      rules.Metadata = &pb.MetadataExtractRules{}
+      metadata := metadata.(map[string]interface{})
+      if value, ok := metadata["include"]; ok {
+        rules.Metadata.Include = value.([]string)
       }
     }
 
+    if schema, ok := data["schema"]; ok {
+      rules.Schema = srv.ToExtractSchema(schema.(map[string]interface{}))
+    }
+
     request.ExtractRules = append(request.ExtractRules, rules)
   }
 
@@ -229,6 +269,137 @@ func (srv *Sources) Save(
   return r, nil
 }
 
+func (srv *Sources) ToArticleExtractRules(article map[string]interface{}) *pb.ArticleExtractRules {
+  rules := &pb.ArticleExtractRules{}
+  if output, ok := article["output"]; ok {
+    rules.Output = output.(string)
+  }
+  if fields, ok := article["fields"]; ok {
+    rules.Fields = srv.ToHtmlExtractFields(fields.([]interface{}))
+  }
+  if computed, ok := article["computed"]; ok {
+    rules.Computed = srv.ToComputedFields(computed.([]map[string]string))
+  }
+  return rules
+}
+
+func (srv *Sources) ToHtmlExtractFields(fields []interface{}) []*pb.HtmlExtractField {
+  var messages []*pb.HtmlExtractField
+  for _, field := range fields {
+    field := field.(map[string]interface{})
+    message := &pb.HtmlExtractField{}
+    if value, ok := field["name"]; ok {
+      message.Name = value.(string)
+    }
+    if value, ok := field["node"]; ok {
+      value := value.(map[string]interface{})
+      message.Node = &pb.HtmlExtractNode{}
+      if value, ok := value["selector"]; ok {
+        message.Node.Selector = value.(string)
+      }
+      if value, ok := value["attr"]; ok {
+        message.Node.Attr = value.(string)
+      }
+      if value, ok := value["index"]; ok {
+        message.Node.Index = value.(uint32)
+      }
+      if value, ok := value["type"]; ok {
+        message.Node.Type = value.(string)
+      }
+      if value, ok := value["resolve"]; ok {
+        message.Node.Resolve = value.(bool)
+      }
+      if value, ok := value["all"]; ok {
+        message.Node.All = value.(bool)
+      }
+      if value, ok := value["fallbacks"]; ok {
+        message.Node.Fallbacks = value.([]string)
+      }
+    }
+    if value, ok := field["output"]; ok {
+      message.Output = value.(string)
+    }
+    if value, ok := field["allow_tags"]; ok {
+      message.AllowTags = value.([]string)
+    }
+    if value, ok := field["match"]; ok {
+      message.Match = value.(string)
+    }
+    if value, ok := field["metadata"]; ok {
+      message.Metadata = value.(string)
+    }
+    if values, ok := field["fields"]; ok {
+      values := values.([]map[string]interface{})
+      var items []interface{}
+      for _, value := range values {
+        items = append(items, value)
+      }
+      message.Fields = srv.ToHtmlExtractFields(items)
+    }
+    if values, ok := field["regex_replace"]; ok {
+      values := values.([]map[string]string)
+      for _, value := range values {
+        replace := &pb.RegexReplace{}
+        if value, ok := value["pattern"]; ok {
+          replace.Pattern = value
+        }
+        if value, ok := value["value"]; ok {
+          replace.Value = value
+        }
+        message.RegexReplace = append(message.RegexReplace, replace)
+      }
+    }
+    if values, ok := field["text_replace"]; ok {
+      values := values.([]map[string]string)
+      for _, value := range values {
+        replace := &pb.TextReplace{}
+        if value, ok := value["text"]; ok {
+          replace.Text = value
+        }
+        if value, ok := value["value"]; ok {
+          replace.Value = value
+        }
+        message.TextReplace = append(message.TextReplace, replace)
+      }
+    }
+    if value, ok := field["regex_extract"]; ok {
+      message.RegexExtract = srv.ToRegexExtract(value.(map[string]interface{}))
+    }
+    if values, ok := field["transforms"]; ok {
+      message.Transforms = srv.ToTransforms(values.([]map[string]string))
+    }
+    messages = append(messages, message)
+  }
+  return messages
+}
+
+func (srv *Sources) ToJsonExtractRules(json map[string]interface{}) *pb.JsonExtractRules {
+  rules := &pb.JsonExtractRules{}
+  if container, ok := json["container"]; ok {
+    rules.Container = container.(string)
+  }
+  if fallbacks, ok := json["container_fallbacks"]; ok {
+    rules.ContainerFallbacks = fallbacks.([]string)
+  }
+  if list, ok := json["list"]; ok {
+    rules.List = list.(string)
+  }
+  if fallbacks, ok := json["list_fallbacks"]; ok {
+    rules.ListFallbacks = fallbacks.([]string)
+  }
+  if fields, ok := json["fields"]; ok {
+    fields := fields.([]interface{})
+    rules.Fields = srv.ToJsonExtractFields(fields)
+  }
+  if computed, ok := json["computed"]; ok {
+    rules.Computed = srv.ToComputedFields(computed.([]map[string]string))
+  }
+  if filters, ok := json["filters"]; ok {
+    rules.Filters = srv.ToFilters(filters.([]interface{}))
+  }
+  return rules
+}
+
 func (srv *Sources) ToJsonExtractFields(fields []interface{}) []*pb.JsonExtractField {
   var result []*pb.JsonExtractField
   for _, field := range fields {
@@ -240,6 +411,9 @@ func (srv *Sources) ToJsonExtractFields(fields []interface{}) []*pb.JsonExtractF
     if value, ok := field["path"]; ok {
       message.Path = value.(string)
     }
+    if value, ok := field["fallbacks"]; ok {
+      message.Fallbacks = value.([]string)
+    }
     if value, ok := field["match"]; ok {
       message.Match = value.(string)
     }
@@ -277,8 +451,210 @@ func (srv *Sources) ToJsonExtractFields(fields []interface{}) []*pb.JsonExtractF
         message.TextReplace = append(message.TextReplace, replace)
       }
     }
+    if value, ok := field["regex_extract"]; ok {
+      message.RegexExtract = srv.ToRegexExtract(value.(map[string]interface{}))
+    }
+    if values, ok := field["transforms"]; ok {
+      message.Transforms = srv.ToTransforms(values.([]map[string]string))
+    }
+    result = append(result, message)
+  }
+
+  return result
+}
+
+func (srv *Sources) ToExtractSchema(schema map[string]interface{}) *pb.ExtractSchema {
+  result := &pb.ExtractSchema{}
+  if value, ok := schema["invalid"]; ok {
+    result.Invalid = value.(string)
+  }
+  if fields, ok := schema["fields"]; ok {
+    for _, field := range fields.([]interface{}) {
+      field := field.(map[string]interface{})
+      message := &pb.SchemaField{}
+      if value, ok := field["name"]; ok {
+        message.Name = value.(string)
+      }
+      if value, ok := field["type"]; ok {
+        message.Type = value.(string)
+      }
+      if value, ok := field["required"]; ok {
+        message.Required = value.(bool)
+      }
+      if value, ok := field["default"]; ok {
+        message.Default = value.(string)
+      }
+      result.Fields = append(result.Fields, message)
+    }
+  }
+  return result
+}
+
+func (srv *Sources) ToComputedFields(values []map[string]string) []*pb.ComputedField {
+  var result []*pb.ComputedField
+  for _, value := range values {
+    result = append(result, &pb.ComputedField{
+      Name:     value["name"],
+      Template: value["template"],
+    })
+  }
+  return result
+}
+
+func (srv *Sources) ToFilters(filters []interface{}) []*pb.Filter {
+  var result []*pb.Filter
+  for _, filter := range filters {
+    filter := filter.(map[string]interface{})
+    message := &pb.Filter{}
+    if value, ok := filter["field"]; ok {
+      message.Field = value.(string)
+    }
+    if value, ok := filter["op"]; ok {
+      message.Op = value.(string)
+    }
+    if value, ok := filter["value"]; ok {
+      message.Value = value.(string)
+    }
+    if values, ok := filter["all"]; ok {
+      message.All = srv.ToFilters(values.([]interface{}))
+    }
+    if values, ok := filter["any"]; ok {
+      message.Any = srv.ToFilters(values.([]interface{}))
+    }
+    if value, ok := filter["not"]; ok {
+      message.Not = srv.ToFilters([]interface{}{value})[0]
+    }
+    result = append(result, message)
+  }
+  return result
+}
+
+func (srv *Sources) ToXmlExtractRules(xml map[string]interface{}) *pb.XmlExtractRules {
+  rules := &pb.XmlExtractRules{}
+  if preset, ok := xml["preset"]; ok {
+    rules.Preset = preset.(string)
+  }
+  if namespaces, ok := xml["namespaces"]; ok {
+    for prefix, url := range namespaces.(map[string]string) {
+      rules.Namespaces = append(rules.Namespaces, &pb.XmlNamespace{
+        Prefix: prefix,
+        Url:    url,
+      })
+    }
+  }
+  if container, ok := xml["container"]; ok {
+    rules.Container = container.(string)
+  }
+  if list, ok := xml["list"]; ok {
+    rules.List = list.(string)
+  }
+  if fields, ok := xml["fields"]; ok {
+    fields := fields.([]interface{})
+    rules.Fields = srv.ToXmlExtractFields(fields)
+  }
+  if filters, ok := xml["filters"]; ok {
+    rules.Filters = srv.ToFilters(filters.([]interface{}))
+  }
+  return rules
+}
+
+func (srv *Sources) ToXmlExtractFields(fields []interface{}) []*pb.XmlExtractField {
+  var result []*pb.XmlExtractField
+  for _, field := range fields {
+    field := field.(map[string]interface{})
+    message := &pb.XmlExtractField{}
+    if value, ok := field["name"]; ok {
+      message.Name = value.(string)
+    }
+    if value, ok := field["path"]; ok {
+      message.Path = value.(string)
+    }
+    if value, ok := field["match"]; ok {
+      message.Match = value.(string)
+    }
+    if values, ok := field["fields"]; ok {
+      values := values.([]map[string]interface{})
+      var items []interface{}
+      for _, value := range values {
+        items = append(items, value)
+      }
+      message.Fields = srv.ToXmlExtractFields(items)
+    }
+    if values, ok := field["regex_replace"]; ok {
+      values := values.([]map[string]string)
+      for _, value := range values {
+        replace := &pb.RegexReplace{}
+        if value, ok := value["pattern"]; ok {
+          replace.Pattern = value
+        }
+        if value, ok := value["value"]; ok {
+          replace.Value = value
+        }
+        message.RegexReplace = append(message.RegexReplace, replace)
+      }
+    }
+    if values, ok := field["text_replace"]; ok {
+      values := values.([]map[string]string)
+      for _, value := range values {
+        replace := &pb.TextReplace{}
+        if value, ok := value["text"]; ok {
+          replace.Text = value
+        }
+        if value, ok := value["value"]; ok {
+          replace.Value = value
+        }
+        message.TextReplace = append(message.TextReplace, replace)
+      }
+    }
+    if value, ok := field["regex_extract"]; ok {
+      message.RegexExtract = srv.ToRegexExtract(value.(map[string]interface{}))
+    }
+    if values, ok := field["transforms"]; ok {
+      message.Transforms = srv.ToTransforms(values.([]map[string]string))
+    }
     result = append(result, message)
   }
 
   return result
 }
+
+func (srv *Sources) ToRegexExtract(value map[string]interface{}) *pb.RegexExtract {
+  extract := &pb.RegexExtract{}
+  if value, ok := value["pattern"]; ok {
+    extract.Pattern = value.(string)
+  }
+  if value, ok := value["group"]; ok {
+    extract.Group = value.(string)
+  }
+  if value, ok := value["all"]; ok {
+    extract.All = value.(bool)
+  }
+  if value, ok := value["miss"]; ok {
+    extract.Miss = value.(string)
+  }
+  return extract
+}
+
+func (srv *Sources) ToTransforms(values []map[string]string) []*pb.Transform {
+  var result []*pb.Transform
+  for _, value := range values {
+    transform := &pb.Transform{}
+    if value, ok := value["type"]; ok {
+      transform.Type = value
+    }
+    if value, ok := value["layout"]; ok {
+      transform.Layout = value
+    }
+    if value, ok := value["timezone"]; ok {
+      transform.Timezone = value
+    }
+    if value, ok := value["separator"]; ok {
+      transform.Separator = value
+    }
+    if value, ok := value["value"]; ok {
+      transform.Value = value
+    }
+    result = append(result, transform)
+  }
+  return result
+}
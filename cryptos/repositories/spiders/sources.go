@@ -19,8 +19,11 @@ func (r *SourcesRepository) Save(
   extractRules map[string]interface{},
   useProxy bool,
   timeout int,
+  method string,
+  body string,
+  contentType string,
 ) (*pb.SaveReply, error) {
-  return r.Service.Save(parentId, name, slug, url, headers, params, extractRules, useProxy, timeout)
+  return r.Service.Save(parentId, name, slug, url, headers, params, extractRules, useProxy, timeout, method, body, contentType)
 }
 
 func (r *SourcesRepository) Get(id string) (*pb.GetReply, error) {
@@ -45,6 +45,7 @@ func (h *DbHandler) migrate() error {
   h.Db.AutoMigrate(
     &models.Source{},
     &models.Task{},
+    &models.Proxy{},
   )
   return nil
 }
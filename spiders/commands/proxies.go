@@ -0,0 +1,117 @@
+package commands
+
+import (
+  "fmt"
+  "log"
+  "strings"
+  "time"
+
+  "github.com/urfave/cli/v2"
+  "gorm.io/gorm"
+
+  "taoniu.local/crawls/spiders/common"
+  "taoniu.local/crawls/spiders/repositories"
+)
+
+type ProxiesHandler struct {
+  Db         *gorm.DB
+  Repository *repositories.ProxiesRepository
+}
+
+func NewProxiesCommand() *cli.Command {
+  var h ProxiesHandler
+  return &cli.Command{
+    Name:  "proxies",
+    Usage: "",
+    Before: func(c *cli.Context) error {
+      h = ProxiesHandler{
+        Db: common.NewDB(),
+      }
+      h.Repository = &repositories.ProxiesRepository{
+        Db: h.Db,
+      }
+      return nil
+    },
+    Subcommands: []*cli.Command{
+      {
+        Name:  "save",
+        Usage: "",
+        Flags: []cli.Flag{
+          &cli.StringFlag{
+            Name:  "pool",
+            Usage: "proxy pool name",
+            Value: "default",
+          },
+          &cli.StringSliceFlag{
+            Name:  "tag",
+            Usage: "proxy tags",
+          },
+        },
+        Action: func(c *cli.Context) error {
+          url := c.Args().Get(0)
+          if url == "" {
+            log.Fatal("url is empty")
+            return nil
+          }
+          if err := h.save(c.String("pool"), url, c.StringSlice("tag")); err != nil {
+            return cli.Exit(err.Error(), 1)
+          }
+          return nil
+        },
+      },
+      {
+        Name:  "list",
+        Usage: "",
+        Action: func(c *cli.Context) error {
+          if err := h.list(c.Args().Get(0)); err != nil {
+            return cli.Exit(err.Error(), 1)
+          }
+          return nil
+        },
+      },
+      {
+        Name:  "delete",
+        Usage: "",
+        Action: func(c *cli.Context) error {
+          id := c.Args().Get(0)
+          if id == "" {
+            log.Fatal("id is empty")
+            return nil
+          }
+          if err := h.delete(id); err != nil {
+            return cli.Exit(err.Error(), 1)
+          }
+          return nil
+        },
+      },
+    },
+  }
+}
+
+func (h *ProxiesHandler) save(pool string, url string, tags []string) error {
+  log.Println("proxies save processing...")
+  return h.Repository.Save(pool, url, tags)
+}
+
+func (h *ProxiesHandler) list(pool string) error {
+  for _, proxy := range h.Repository.All(pool) {
+    fields := []string{
+      proxy.ID,
+      proxy.Pool,
+      fmt.Sprintf("%s://%s:%d", proxy.Protocol, proxy.Host, proxy.Port),
+      string(proxy.Tags),
+      fmt.Sprintf("score=%.1f", proxy.Score),
+      fmt.Sprintf("latency=%dms", proxy.Latency),
+    }
+    if proxy.BenchedUntil.After(time.Now()) {
+      fields = append(fields, fmt.Sprintf("benched_until=%s", proxy.BenchedUntil.Format(time.RFC3339)))
+    }
+    fmt.Println(strings.Join(fields, "\t"))
+  }
+  return nil
+}
+
+func (h *ProxiesHandler) delete(id string) error {
+  log.Println("proxies delete processing...")
+  return h.Repository.Delete(id)
+}
@@ -1,15 +1,32 @@
 package commands
 
 import (
+  "encoding/json"
+  "fmt"
   "gorm.io/gorm"
+  "io/ioutil"
   "log"
 
   "github.com/urfave/cli/v2"
 
   "taoniu.local/crawls/spiders/common"
+  "taoniu.local/crawls/spiders/models"
   "taoniu.local/crawls/spiders/repositories"
 )
 
+type SourceDefinition struct {
+  Slug         string                                `json:"slug"`
+  Url          string                                `json:"url"`
+  Headers      map[string]string                     `json:"headers"`
+  Params       map[string]interface{}                `json:"params"`
+  UseProxy     bool                                  `json:"use_proxy"`
+  Timeout      int                                   `json:"timeout"`
+  Method       string                                `json:"method"`
+  Body         string                                `json:"body"`
+  ContentType  string                                `json:"content_type"`
+  ExtractRules map[string]*repositories.ExtractRules `json:"extract_rules"`
+}
+
 type SourcesHandler struct {
   Db         *gorm.DB
   Repository *repositories.SourcesRepository
@@ -40,6 +57,35 @@ func NewSourcesCommand() *cli.Command {
           return nil
         },
       },
+      {
+        Name:  "preview",
+        Usage: "",
+        Flags: []cli.Flag{
+          &cli.StringFlag{
+            Name:  "source",
+            Usage: "source definition json file",
+          },
+          &cli.StringFlag{
+            Name:  "url",
+            Usage: "url to fetch",
+          },
+          &cli.StringFlag{
+            Name:  "file",
+            Usage: "inline html/json body file",
+          },
+        },
+        Action: func(c *cli.Context) error {
+          slug := c.Args().Get(0)
+          if slug == "" && c.String("source") == "" {
+            log.Fatal("slug is empty")
+            return nil
+          }
+          if err := h.preview(slug, c.String("source"), c.String("url"), c.String("file")); err != nil {
+            return cli.Exit(err.Error(), 1)
+          }
+          return nil
+        },
+      },
       {
         Name:  "flush",
         Usage: "",
@@ -214,6 +260,7 @@ func (h *SourcesHandler) save() error {
   }
   useProxy := true
   timeout := 10
+  method := "GET"
 
   return h.Repository.Save(
     parentId,
@@ -224,6 +271,9 @@ func (h *SourcesHandler) save() error {
     params,
     useProxy,
     timeout,
+    method,
+    "",
+    "",
     extractRules,
   )
 }
@@ -240,3 +290,73 @@ func (h *SourcesHandler) flush(slug string) error {
   }
   return nil
 }
+
+func (h *SourcesHandler) preview(slug string, definition string, url string, file string) error {
+  log.Println("sources preview processing...")
+
+  var source *models.Source
+  var extractRules map[string]*repositories.ExtractRules
+  var err error
+  if definition != "" {
+    buf, err := ioutil.ReadFile(definition)
+    if err != nil {
+      return err
+    }
+    var data *SourceDefinition
+    err = json.Unmarshal(buf, &data)
+    if err != nil {
+      return err
+    }
+    err = h.Repository.ValidateRequest(data.Method, data.Body)
+    if err != nil {
+      return err
+    }
+    err = h.Repository.ValidateRules(data.Params, data.ExtractRules)
+    if err != nil {
+      return err
+    }
+    source = &models.Source{
+      Slug:        data.Slug,
+      Url:         data.Url,
+      Headers:     h.Repository.JSONMap(data.Headers),
+      Params:      h.Repository.JSONMap(data.Params),
+      UseProxy:    data.UseProxy,
+      Timeout:     data.Timeout,
+      Method:      data.Method,
+      Body:        data.Body,
+      ContentType: data.ContentType,
+    }
+    extractRules = data.ExtractRules
+  } else {
+    source, err = h.Repository.GetBySlug(slug)
+    if err != nil {
+      return err
+    }
+    extractRules, err = h.Repository.Rules(source)
+    if err != nil {
+      return err
+    }
+  }
+
+  var content string
+  if file != "" {
+    buf, err := ioutil.ReadFile(file)
+    if err != nil {
+      return err
+    }
+    content = string(buf)
+  }
+
+  result, err := h.Repository.Preview(source, extractRules, url, content)
+  if err != nil {
+    return err
+  }
+
+  buf, err := json.MarshalIndent(result, "", "  ")
+  if err != nil {
+    return err
+  }
+  fmt.Println(string(buf))
+
+  return nil
+}
@@ -1,11 +1,67 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
+var (
+	relativePattern = regexp.MustCompile(`^(\d+|半|一|两|an?|one)\s*(秒钟?|分钟|分|小时|个小时|钟头|个钟头|天|日|周|星期|个星期|个月|月|年|seconds?|secs?|minutes?|mins?|hours?|hrs?|days?|weeks?|months?|years?)\s*(前|之前|以前|ago)$`)
+	dayPattern      = regexp.MustCompile(`^(今天|昨天|前天|大前天|today|yesterday)\s*(\d{1,2}:\d{2}(:\d{2})?)?$`)
+	unixPattern     = regexp.MustCompile(`^\d{9,14}$`)
+)
+
+var datetimeLayouts = []string{
+	time.RFC3339,
+	"2006-1-2T15:04:05",
+	"2006-1-2 15:04:05",
+	"2006-1-2 15:04",
+	"2006-1-2",
+	"2006/1/2 15:04:05",
+	"2006/1/2 15:04",
+	"2006/1/2",
+	"2006.1.2 15:04:05",
+	"2006.1.2 15:04",
+	"2006.1.2",
+	"2006年1月2日 15:04:05",
+	"2006年1月2日 15:04",
+	"2006年1月2日15:04",
+	"2006年1月2日",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC822Z,
+	time.RFC822,
+	"Jan 2, 2006 15:04",
+	"Jan 2, 2006",
+	"2 Jan 2006 15:04",
+	"2 Jan 2006",
+}
+
+var partialLayouts = []string{
+	"1-2 15:04:05",
+	"1-2 15:04",
+	"1-2",
+	"1/2 15:04:05",
+	"1/2 15:04",
+	"1/2",
+	"1月2日 15:04:05",
+	"1月2日 15:04",
+	"1月2日15:04",
+	"1月2日",
+	"Jan 2 15:04",
+	"Jan 2",
+}
+
+var clockLayouts = []string{
+	"15:04:05",
+	"15:04",
+}
+
 func FormatDatetime(datetime time.Time) string {
 	now := time.Now()
 	lastMonth := now.AddDate(0, -1, 0)
@@ -62,3 +118,123 @@ func FormatDatetime(datetime time.Time) string {
 		return fmt.Sprintf("%d", datetime.Year())
 	}
 }
+
+func ParseDatetime(text string, now time.Time, location *time.Location) (time.Time, error) {
+	text = strings.TrimSpace(text)
+	if location == nil {
+		location = time.Local
+	}
+	now = now.In(location)
+	lower := strings.ToLower(text)
+
+	switch lower {
+	case "":
+		return time.Time{}, errors.New("datetime is empty")
+	case "刚刚", "刚才", "just now", "now":
+		return now, nil
+	case "半小时前":
+		return now.Add(-30 * time.Minute), nil
+	}
+
+	if unixPattern.MatchString(text) {
+		value, _ := strconv.ParseInt(text, 10, 64)
+		if len(text) > 11 {
+			return time.UnixMilli(value).In(location), nil
+		}
+		return time.Unix(value, 0).In(location), nil
+	}
+
+	if matches := relativePattern.FindStringSubmatch(lower); matches != nil {
+		return parseRelative(matches[1], matches[2], now)
+	}
+
+	if matches := dayPattern.FindStringSubmatch(lower); matches != nil {
+		days := map[string]int{
+			"今天":        0,
+			"today":     0,
+			"昨天":        1,
+			"yesterday": 1,
+			"前天":        2,
+			"大前天":       3,
+		}[matches[1]]
+		day := now.AddDate(0, 0, -days)
+		if matches[2] == "" {
+			return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, location), nil
+		}
+		clock, err := parseLayouts(matches[2], clockLayouts, location)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, location), nil
+	}
+
+	if datetime, err := parseLayouts(text, datetimeLayouts, location); err == nil {
+		return datetime, nil
+	}
+
+	if partial, err := parseLayouts(text, partialLayouts, location); err == nil {
+		for year := now.Year(); year > now.Year()-8; year-- {
+			datetime := time.Date(year, partial.Month(), partial.Day(), partial.Hour(), partial.Minute(), partial.Second(), 0, location)
+			if datetime.Month() != partial.Month() || datetime.After(now.AddDate(0, 0, 1)) {
+				continue
+			}
+			return datetime, nil
+		}
+	}
+
+	if clock, err := parseLayouts(text, clockLayouts, location); err == nil {
+		datetime := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, location)
+		if datetime.After(now) {
+			datetime = datetime.AddDate(0, 0, -1)
+		}
+		return datetime, nil
+	}
+
+	return time.Time{}, errors.New(fmt.Sprintf("datetime %s not supported", text))
+}
+
+func parseRelative(count string, unit string, now time.Time) (time.Time, error) {
+	var n int
+	switch count {
+	case "半":
+		if strings.HasPrefix(unit, "小时") || strings.HasPrefix(unit, "个小时") {
+			return now.Add(-30 * time.Minute), nil
+		}
+		if unit == "天" || unit == "日" {
+			return now.Add(-12 * time.Hour), nil
+		}
+		return time.Time{}, errors.New(fmt.Sprintf("datetime 半%s前 not supported", unit))
+	case "一", "a", "an", "one":
+		n = 1
+	case "两":
+		n = 2
+	default:
+		n, _ = strconv.Atoi(count)
+	}
+
+	switch {
+	case strings.HasPrefix(unit, "秒"), strings.HasPrefix(unit, "sec"):
+		return now.Add(-time.Duration(n) * time.Second), nil
+	case strings.HasPrefix(unit, "分"), strings.HasPrefix(unit, "min"):
+		return now.Add(-time.Duration(n) * time.Minute), nil
+	case strings.Contains(unit, "小时"), strings.Contains(unit, "钟头"), strings.HasPrefix(unit, "h"):
+		return now.Add(-time.Duration(n) * time.Hour), nil
+	case unit == "天", unit == "日", strings.HasPrefix(unit, "day"):
+		return now.AddDate(0, 0, -n), nil
+	case strings.Contains(unit, "周"), strings.Contains(unit, "星期"), strings.HasPrefix(unit, "week"):
+		return now.AddDate(0, 0, -7*n), nil
+	case strings.Contains(unit, "月"), strings.HasPrefix(unit, "month"):
+		return now.AddDate(0, -n, 0), nil
+	}
+	return now.AddDate(-n, 0, 0), nil
+}
+
+func parseLayouts(text string, layouts []string, location *time.Location) (time.Time, error) {
+	for _, layout := range layouts {
+		datetime, err := time.ParseInLocation(layout, text, location)
+		if err == nil {
+			return datetime.In(location), nil
+		}
+	}
+	return time.Time{}, errors.New(fmt.Sprintf("datetime %s not supported", text))
+}
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDatetime(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	now := time.Date(2024, 1, 2, 10, 30, 0, 0, location)
+
+	tests := []struct {
+		text     string
+		expected time.Time
+	}{
+		{"刚刚", now},
+		{"just now", now},
+		{"半小时前", now.Add(-30 * time.Minute)},
+		{"30秒前", now.Add(-30 * time.Second)},
+		{"5分钟前", now.Add(-5 * time.Minute)},
+		{"2小时前", now.Add(-2 * time.Hour)},
+		{"两个小时前", now.Add(-2 * time.Hour)},
+		{"半天前", now.Add(-12 * time.Hour)},
+		{"3天前", now.AddDate(0, 0, -3)},
+		{"3 天前", now.AddDate(0, 0, -3)},
+		{"1周前", now.AddDate(0, 0, -7)},
+		{"2个月前", now.AddDate(0, -2, 0)},
+		{"1年前", now.AddDate(-1, 0, 0)},
+		{"an hour ago", now.Add(-time.Hour)},
+		{"3 Days Ago", now.AddDate(0, 0, -3)},
+		{"今天", time.Date(2024, 1, 2, 0, 0, 0, 0, location)},
+		{"今天 08:15", time.Date(2024, 1, 2, 8, 15, 0, 0, location)},
+		{"昨天 12:00", time.Date(2024, 1, 1, 12, 0, 0, 0, location)},
+		{"前天 23:59:30", time.Date(2023, 12, 31, 23, 59, 30, 0, location)},
+		{"yesterday", time.Date(2024, 1, 1, 0, 0, 0, 0, location)},
+		{"1704162600", now},
+		{"1704162600000", now},
+		{"1704162600123", now.Add(123 * time.Millisecond)},
+		{"2023-05-06 07:08:09", time.Date(2023, 5, 6, 7, 8, 9, 0, location)},
+		{"2023/5/6 07:08", time.Date(2023, 5, 6, 7, 8, 0, 0, location)},
+		{"2023年5月6日 07:08", time.Date(2023, 5, 6, 7, 8, 0, 0, location)},
+		{"2023-05-06T07:08:09Z", time.Date(2023, 5, 6, 15, 8, 9, 0, location)},
+		{"Mon, 02 Jan 2006 15:04:05 +0000", time.Date(2006, 1, 2, 23, 4, 5, 0, location)},
+		{"01-02 09:00", time.Date(2024, 1, 2, 9, 0, 0, 0, location)},
+		{"1月3日", time.Date(2024, 1, 3, 0, 0, 0, 0, location)},
+		{"12-31 23:50", time.Date(2023, 12, 31, 23, 50, 0, 0, location)},
+		{"12月25日", time.Date(2023, 12, 25, 0, 0, 0, 0, location)},
+		{"Dec 30", time.Date(2023, 12, 30, 0, 0, 0, 0, location)},
+		{"2-29", time.Date(2020, 2, 29, 0, 0, 0, 0, location)},
+		{"09:00", time.Date(2024, 1, 2, 9, 0, 0, 0, location)},
+		{"11:00", time.Date(2024, 1, 1, 11, 0, 0, 0, location)},
+	}
+	for _, test := range tests {
+		datetime, err := ParseDatetime(test.text, now, location)
+		if err != nil {
+			t.Errorf("parse %q: %v", test.text, err)
+			continue
+		}
+		if !datetime.Equal(test.expected) {
+			t.Errorf("parse %q: got %v, want %v", test.text, datetime, test.expected)
+		}
+		if datetime.Location() != location {
+			t.Errorf("parse %q: location %v, want %v", test.text, datetime.Location(), location)
+		}
+	}
+
+	for _, text := range []string{"", "半月前", "明天", "2023-13-01", "12345"} {
+		if _, err := ParseDatetime(text, now, location); err == nil {
+			t.Errorf("parse %q: expected error", text)
+		}
+	}
+}
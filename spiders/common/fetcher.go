@@ -0,0 +1,80 @@
+package common
+
+import (
+  "errors"
+  "fmt"
+  "net"
+  "net/http"
+  "net/url"
+  "strings"
+  "time"
+)
+
+type FetchRequest struct {
+  Method      string
+  Url         string
+  Body        string
+  ContentType string
+  Headers     map[string]string
+  Proxy       string
+  Timeout     int
+}
+
+type Fetcher interface {
+  Fetch(request *FetchRequest) (*http.Response, error)
+}
+
+type HttpFetcher struct{}
+
+func (f *HttpFetcher) Fetch(request *FetchRequest) (*http.Response, error) {
+  tr := &http.Transport{
+    DisableKeepAlives: true,
+  }
+
+  if request.Proxy != "" {
+    proxy, err := url.Parse(request.Proxy)
+    if err != nil {
+      return nil, err
+    }
+    switch proxy.Scheme {
+    case "socks5":
+      session := &ProxySession{
+        Proxy: request.Proxy,
+      }
+      tr.DialContext = session.DialContext
+    case "http", "https":
+      tr.Proxy = http.ProxyURL(proxy)
+    default:
+      return nil, errors.New(fmt.Sprintf("proxy %s not supported", proxy.Scheme))
+    }
+  } else {
+    session := &net.Dialer{}
+    tr.DialContext = session.DialContext
+  }
+
+  httpClient := &http.Client{
+    Transport: tr,
+    Timeout:   time.Duration(request.Timeout) * time.Second,
+  }
+
+  method := request.Method
+  if method == "" {
+    method = http.MethodGet
+  }
+
+  req, err := http.NewRequest(method, request.Url, strings.NewReader(request.Body))
+  if err != nil {
+    return nil, err
+  }
+  if request.Body == "" {
+    req.Body = http.NoBody
+    req.ContentLength = 0
+  }
+  for key, val := range request.Headers {
+    req.Header.Set(key, val)
+  }
+  if request.ContentType != "" {
+    req.Header.Set("Content-Type", request.ContentType)
+  }
+  return httpClient.Do(req)
+}
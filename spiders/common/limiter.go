@@ -0,0 +1,137 @@
+package common
+
+import (
+  "context"
+  "fmt"
+  "math/rand"
+  "os"
+  "strconv"
+  "time"
+
+  "github.com/go-redis/redis/v8"
+  "github.com/rs/xid"
+)
+
+var (
+  acquireScript = redis.NewScript(`
+  local now = tonumber(ARGV[1])
+  local interval = tonumber(ARGV[2])
+  local concurrency = tonumber(ARGV[3])
+  local ttl = tonumber(ARGV[4])
+  if concurrency > 0 then
+    redis.call("ZREMRANGEBYSCORE", KEYS[2], "-inf", now)
+    if redis.call("ZCARD", KEYS[2]) >= concurrency then
+      return -1
+    end
+  end
+  local next = tonumber(redis.call("GET", KEYS[1]) or "0")
+  if next > now then
+    return next - now
+  end
+  if interval > 0 then
+    redis.call("SET", KEYS[1], now + interval, "PX", interval + 1000)
+  end
+  if concurrency > 0 then
+    redis.call("ZADD", KEYS[2], now + ttl, ARGV[5])
+    redis.call("PEXPIRE", KEYS[2], ttl)
+  end
+  return 0
+  `)
+)
+
+// RateLimit holds per-host limits: Rate in requests per second, Concurrency in
+// requests in flight and Jitter as the upper bound of a random delay in milliseconds.
+type RateLimit struct {
+  Rate        float64 `json:"rate"`
+  Concurrency int     `json:"concurrency"`
+  Jitter      int     `json:"jitter"`
+}
+
+type HostLimiter struct {
+  rdb   *redis.Client
+  ctx   context.Context
+  host  string
+  limit *RateLimit
+  ttl   time.Duration
+  lease string
+}
+
+func DefaultRateLimit() *RateLimit {
+  limit := &RateLimit{}
+  limit.Rate, _ = strconv.ParseFloat(os.Getenv("SPIDERS_HOST_RATE"), 64)
+  limit.Concurrency, _ = strconv.Atoi(os.Getenv("SPIDERS_HOST_CONCURRENCY"))
+  limit.Jitter, _ = strconv.Atoi(os.Getenv("SPIDERS_HOST_JITTER"))
+  return limit
+}
+
+func NewHostLimiter(
+  rdb *redis.Client,
+  ctx context.Context,
+  host string,
+  limit *RateLimit,
+  ttl time.Duration,
+) *HostLimiter {
+  return &HostLimiter{
+    rdb:   rdb,
+    ctx:   ctx,
+    host:  host,
+    limit: limit,
+    ttl:   ttl,
+  }
+}
+
+func (l *HostLimiter) Acquire() (bool, time.Duration) {
+  var interval int64
+  if l.limit.Rate > 0 {
+    interval = int64(1000 / l.limit.Rate)
+  }
+  lease := xid.New().String()
+  result, err := acquireScript.Run(
+    l.ctx,
+    l.rdb,
+    []string{
+      fmt.Sprintf("limits:spiders:hosts:%s:next", l.host),
+      fmt.Sprintf("limits:spiders:hosts:%s:inflight", l.host),
+    },
+    time.Now().UnixMilli(),
+    interval,
+    l.limit.Concurrency,
+    l.ttl.Milliseconds(),
+    lease,
+  ).Int64()
+  if err != nil {
+    return true, 0
+  }
+  if result == 0 {
+    l.lease = lease
+    return true, 0
+  }
+
+  wait := time.Duration(result) * time.Millisecond
+  if result < 0 {
+    wait = time.Second
+    if interval > 0 {
+      wait = time.Duration(interval) * time.Millisecond
+    }
+  }
+  return false, wait + l.Jitter()
+}
+
+func (l *HostLimiter) Release() {
+  if l.limit.Concurrency <= 0 || l.lease == "" {
+    return
+  }
+  l.rdb.ZRem(
+    l.ctx,
+    fmt.Sprintf("limits:spiders:hosts:%s:inflight", l.host),
+    l.lease,
+  )
+  l.lease = ""
+}
+
+func (l *HostLimiter) Jitter() time.Duration {
+  if l.limit.Jitter <= 0 {
+    return 0
+  }
+  return time.Duration(rand.Intn(l.limit.Jitter)) * time.Millisecond
+}
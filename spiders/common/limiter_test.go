@@ -0,0 +1,109 @@
+package common
+
+import (
+  "context"
+  "testing"
+  "time"
+
+  "github.com/alicebob/miniredis/v2"
+  "github.com/go-redis/redis/v8"
+)
+
+func newLimiterRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+  mr := miniredis.RunT(t)
+  rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+  t.Cleanup(func() {
+    rdb.Close()
+  })
+  return mr, rdb
+}
+
+func TestHostLimiterConcurrency(t *testing.T) {
+  _, rdb := newLimiterRedis(t)
+  limit := &RateLimit{Concurrency: 2}
+  limiters := []*HostLimiter{}
+  for i := 0; i < 3; i++ {
+    limiters = append(limiters, NewHostLimiter(rdb, context.Background(), "example.com", limit, time.Minute))
+  }
+
+  for i, limiter := range limiters[:2] {
+    if acquired, wait := limiter.Acquire(); !acquired || wait != 0 {
+      t.Fatalf("acquire %d: got %v %v", i, acquired, wait)
+    }
+  }
+  if acquired, wait := limiters[2].Acquire(); acquired || wait != time.Second {
+    t.Errorf("acquire full: got %v %v, want false 1s", acquired, wait)
+  }
+
+  limiters[0].Release()
+  limiters[0].Release()
+  if acquired, _ := limiters[2].Acquire(); !acquired {
+    t.Error("acquire after release: slot not freed")
+  }
+  if acquired, _ := limiters[0].Acquire(); acquired {
+    t.Error("acquire after double release: released more than one slot")
+  }
+
+  other := NewHostLimiter(rdb, context.Background(), "example.org", limit, time.Minute)
+  if acquired, _ := other.Acquire(); !acquired {
+    t.Error("acquire other host: limited by example.com")
+  }
+}
+
+func TestHostLimiterLeaseExpiry(t *testing.T) {
+  _, rdb := newLimiterRedis(t)
+  limit := &RateLimit{Concurrency: 2}
+  ttl := 100 * time.Millisecond
+
+  leaked := NewHostLimiter(rdb, context.Background(), "example.com", limit, ttl)
+  if acquired, _ := leaked.Acquire(); !acquired {
+    t.Fatal("acquire leaked: not acquired")
+  }
+
+  steady := NewHostLimiter(rdb, context.Background(), "example.com", limit, ttl)
+  deadline := time.Now().Add(2 * ttl)
+  for time.Now().Before(deadline) {
+    if acquired, _ := steady.Acquire(); !acquired {
+      t.Fatal("acquire steady: not acquired")
+    }
+    steady.Release()
+    time.Sleep(10 * time.Millisecond)
+  }
+
+  for i := 0; i < 2; i++ {
+    limiter := NewHostLimiter(rdb, context.Background(), "example.com", limit, ttl)
+    if acquired, _ := limiter.Acquire(); !acquired {
+      t.Fatalf("acquire %d after lease expiry: leaked slot still held", i)
+    }
+  }
+}
+
+func TestHostLimiterRate(t *testing.T) {
+  _, rdb := newLimiterRedis(t)
+  limiter := NewHostLimiter(rdb, context.Background(), "example.com", &RateLimit{Rate: 10}, time.Minute)
+
+  if acquired, _ := limiter.Acquire(); !acquired {
+    t.Fatal("acquire first: not acquired")
+  }
+  acquired, wait := limiter.Acquire()
+  if acquired || wait <= 0 || wait > 100*time.Millisecond {
+    t.Errorf("acquire within interval: got %v %v", acquired, wait)
+  }
+  time.Sleep(wait)
+  if acquired, _ := limiter.Acquire(); !acquired {
+    t.Error("acquire after interval: not acquired")
+  }
+}
+
+func TestHostLimiterJitter(t *testing.T) {
+  limiter := NewHostLimiter(nil, context.Background(), "example.com", &RateLimit{Jitter: 50}, time.Minute)
+  for i := 0; i < 100; i++ {
+    if jitter := limiter.Jitter(); jitter < 0 || jitter >= 50*time.Millisecond {
+      t.Fatalf("jitter: got %v, want [0, 50ms)", jitter)
+    }
+  }
+  limiter = NewHostLimiter(nil, context.Background(), "example.com", &RateLimit{}, time.Minute)
+  if jitter := limiter.Jitter(); jitter != 0 {
+    t.Errorf("jitter disabled: got %v", jitter)
+  }
+}
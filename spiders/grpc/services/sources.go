@@ -2,10 +2,12 @@ package services
 
 import (
   "context"
+  "encoding/json"
   "google.golang.org/grpc"
   "google.golang.org/protobuf/types/known/timestamppb"
   "gorm.io/gorm"
   pb "taoniu.local/crawls/spiders/grpc/sources"
+  "taoniu.local/crawls/spiders/models"
   "taoniu.local/crawls/spiders/repositories"
 )
 
@@ -30,15 +32,18 @@ func (srv *Sources) Get(ctx context.Context, request *pb.GetRequest) (*pb.GetRep
   }
 
   reply.Data = &pb.SourceInfo{
-    Id:        source.ID,
-    Name:      source.Name,
-    Slug:      source.Slug,
-    Url:       source.Url,
-    UseProxy:  source.UseProxy,
-    Timeout:   int32(source.Timeout),
-    Status:    int32(source.Status),
-    CreatedAt: timestamppb.New(source.CreatedAt),
-    UpdatedAt: timestamppb.New(source.UpdatedAt),
+    Id:          source.ID,
+    Name:        source.Name,
+    Slug:        source.Slug,
+    Url:         source.Url,
+    UseProxy:    source.UseProxy,
+    Timeout:     int32(source.Timeout),
+    Method:      source.Method,
+    Body:        source.Body,
+    ContentType: source.ContentType,
+    Status:      int32(source.Status),
+    CreatedAt:   timestamppb.New(source.CreatedAt),
+    UpdatedAt:   timestamppb.New(source.UpdatedAt),
   }
 
   for name, value := range source.Headers {
@@ -64,15 +69,18 @@ func (srv *Sources) GetBySlug(ctx context.Context, request *pb.GetBySlugRequest)
   }
 
   reply.Data = &pb.SourceInfo{
-    Id:        source.ID,
-    Name:      source.Name,
-    Slug:      source.Slug,
-    Url:       source.Url,
-    UseProxy:  source.UseProxy,
-    Timeout:   int32(source.Timeout),
-    Status:    int32(source.Status),
-    CreatedAt: timestamppb.New(source.CreatedAt),
-    UpdatedAt: timestamppb.New(source.UpdatedAt),
+    Id:          source.ID,
+    Name:        source.Name,
+    Slug:        source.Slug,
+    Url:         source.Url,
+    UseProxy:    source.UseProxy,
+    Timeout:     int32(source.Timeout),
+    Method:      source.Method,
+    Body:        source.Body,
+    ContentType: source.ContentType,
+    Status:      int32(source.Status),
+    CreatedAt:   timestamppb.New(source.CreatedAt),
+    UpdatedAt:   timestamppb.New(source.UpdatedAt),
   }
 
   for name, value := range source.Headers {
@@ -93,27 +101,123 @@ func (srv *Sources) GetBySlug(ctx context.Context, request *pb.GetBySlugRequest)
 func (srv *Sources) Save(ctx context.Context, request *pb.SaveRequest) (*pb.SaveReply, error) {
   reply := &pb.SaveReply{}
 
+  headers := srv.MapHeaders(request.Headers)
+  params := srv.MapParams(request.Params)
+  extractRules := srv.MapExtractRulesList(request.ExtractRules)
+
+  err := srv.Repository.Save(
+    request.ParentId,
+    request.Name,
+    request.Slug,
+    request.Url,
+    headers,
+    params,
+    request.UseProxy,
+    int(request.Timeout),
+    request.Method,
+    request.Body,
+    request.ContentType,
+    extractRules,
+  )
+  if err != nil {
+    reply.Message = err.Error()
+  } else {
+    reply.Success = true
+  }
+  return reply, nil
+}
+
+func (srv *Sources) Preview(ctx context.Context, request *pb.PreviewRequest) (*pb.PreviewReply, error) {
+  reply := &pb.PreviewReply{}
+
+  var source *models.Source
+  var extractRules map[string]*repositories.ExtractRules
+  var err error
+  if request.Source != nil {
+    headers := srv.MapHeaders(request.Source.Headers)
+    params := srv.MapParams(request.Source.Params)
+    extractRules = srv.MapExtractRulesList(request.Source.ExtractRules)
+    err = srv.Repository.ValidateRequest(request.Source.Method, request.Source.Body)
+    if err != nil {
+      reply.Message = err.Error()
+      return reply, nil
+    }
+    err = srv.Repository.ValidateRules(params, extractRules)
+    if err != nil {
+      reply.Message = err.Error()
+      return reply, nil
+    }
+    source = &models.Source{
+      Slug:        request.Source.Slug,
+      Url:         request.Source.Url,
+      Headers:     srv.Repository.JSONMap(headers),
+      Params:      srv.Repository.JSONMap(params),
+      UseProxy:    request.Source.UseProxy,
+      Timeout:     int(request.Source.Timeout),
+      Method:      request.Source.Method,
+      Body:        request.Source.Body,
+      ContentType: request.Source.ContentType,
+    }
+  } else {
+    source, err = srv.Repository.GetBySlug(request.Slug)
+    if err != nil {
+      reply.Message = err.Error()
+      return reply, nil
+    }
+    extractRules, err = srv.Repository.Rules(source)
+    if err != nil {
+      reply.Message = err.Error()
+      return reply, nil
+    }
+  }
+
+  result, err := srv.Repository.Preview(source, extractRules, request.Url, request.Content)
+  if err != nil {
+    reply.Message = err.Error()
+    return reply, nil
+  }
+
+  data, _ := json.Marshal(result.Result)
+  reply.Result = string(data)
+  data, _ = json.Marshal(result.Rejected)
+  reply.Rejected = string(data)
+  reply.RejectedCount = uint32(result.RejectedCount)
+  data, _ = json.Marshal(result.Matches)
+  reply.Matches = string(data)
+  reply.Urls = result.Urls
+  reply.Success = true
+
+  return reply, nil
+}
+
+func (srv *Sources) MapHeaders(data []*pb.HttpHeader) map[string]string {
   headers := map[string]string{}
-  for _, header := range request.Headers {
+  for _, header := range data {
     headers[header.Name] = header.Value
   }
+  return headers
+}
 
+func (srv *Sources) MapParams(data *pb.Params) map[string]interface{} {
   params := map[string]interface{}{}
+  if data == nil {
+    return params
+  }
 
   split := map[string]interface{}{}
-  for _, value := range request.Params.Split {
+  for _, value := range data.Split {
     split[value.Parent] = value.Path
   }
-  if len(request.Params.Split) > 0 {
+  if len(data.Split) > 0 {
     params["split"] = split
   }
 
-  if request.Params.Scroll != "" {
-    params["scroll"] = request.Params.Scroll
+  if data.Scroll != "" {
+    params["scroll"] = data.Scroll
   }
 
   var query []map[string]string
-  for _, item := range request.Params.Query {
+  for _, item := range data.Query {
     query = append(query, map[string]string{
       "name":    item.Name,
       "value":   item.Value,
@@ -124,56 +228,85 @@ func (srv *Sources) Save(ctx context.Context, request *pb.SaveRequest) (*pb.Save
     params["query"] = query
   }
 
-  extractRules := map[string]*repositories.ExtractRules{}
-  for _, rules := range request.ExtractRules {
-    extractRules[rules.Name] = srv.MapExtractRules(rules)
+  if data.Proxy != nil {
+    params["proxy"] = map[string]interface{}{
+      "pool":     data.Proxy.Pool,
+      "tag":      data.Proxy.Tag,
+      "rotation": data.Proxy.Rotation,
+    }
   }
 
-  err := srv.Repository.Save(
-    request.ParentId,
-    request.Name,
-    request.Slug,
-    request.Url,
-    headers,
-    params,
-    request.UseProxy,
-    int(request.Timeout),
-    extractRules,
-  )
-  if err != nil {
-    reply.Message = err.Error()
-  } else {
-    reply.Success = true
+  if data.Limit != nil {
+    params["limit"] = map[string]interface{}{
+      "rate":        data.Limit.Rate,
+      "concurrency": float64(data.Limit.Concurrency),
+      "jitter":      float64(data.Limit.Jitter),
+    }
   }
-  return reply, nil
+
+  if data.IgnoreRobots {
+    params["robots"] = false
+  }
+
+  if data.Timezone != "" {
+    params["timezone"] = data.Timezone
+  }
+
+  if data.Charset != "" {
+    params["charset"] = data.Charset
+  }
+
+  return params
+}
+
+func (srv *Sources) MapExtractRulesList(data []*pb.ExtractRules) map[string]*repositories.ExtractRules {
+  extractRules := map[string]*repositories.ExtractRules{}
+  for _, rules := range data {
+    extractRules[rules.Name] = srv.MapExtractRules(rules)
+  }
+  return extractRules
 }
 
 func (srv *Sources) MapExtractRules(data *pb.ExtractRules) *repositories.ExtractRules {
-  rules := &repositories.ExtractRules{}
+  rules := &repositories.ExtractRules{
+    Input:    data.Input,
+    Internal: data.Internal,
+  }
   if data.Html != nil {
     rules.Html = srv.MapHtmlExtractRules(data.Html)
   }
   if data.Json != nil {
     rules.Json = srv.MapJsonExtractRules(data.Json)
   }
+  if data.Script != nil {
+    rules.Script = srv.MapScriptExtractRules(data.Script)
+  }
+  if data.Xml != nil {
+    rules.Xml = srv.MapXmlExtractRules(data.Xml)
+  }
+  if data.Article != nil {
+    rules.Article = srv.MapArticleExtractRules(data.Article)
+  }
+  if data.Metadata != nil {
+    rules.Metadata = &repositories.MetadataExtractRules{
+      Include: data.Metadata.Include,
+    }
+  }
+  if data.Schema != nil {
+    rules.Schema = srv.MapExtractSchema(data.Schema)
+  }
   return rules
 }
 
 func (srv *Sources) MapHtmlExtractRules(data *pb.HtmlExtractRules) *repositories.HtmlExtractRules {
   rules := &repositories.HtmlExtractRules{}
-  rules.Container = &repositories.HtmlExtractNode{
-    Selector: data.Container.Selector,
-    Attr:     data.Container.Attr,
-    Index:    int(data.Container.Index),
-  }
+  rules.Container = srv.MapHtmlExtractNode(data.Container)
   if data.List != nil {
-    rules.List = &repositories.HtmlExtractNode{
-      Selector: data.List.Selector,
-      Attr:     data.List.Attr,
-      Index:    int(data.List.Index),
-    }
+    rules.List = srv.MapHtmlExtractNode(data.List)
   }
   rules.Fields = srv.MapHtmlExtractField(data.Fields)
+  rules.Computed = srv.MapComputedFields(data.Computed)
+  rules.Filters = srv.MapFilters(data.Filters)
 
   return rules
 }
@@ -182,14 +315,13 @@ func (srv *Sources) MapHtmlExtractField(items []*pb.HtmlExtractField) []*reposit
   var fields []*repositories.HtmlExtractField
   for _, item := range items {
     field := &repositories.HtmlExtractField{
-      Name:  item.Name,
-      Match: item.Match,
-    }
-    field.Node = &repositories.HtmlExtractNode{
-      Selector: item.Node.Selector,
-      Attr:     item.Node.Attr,
-      Index:    int(item.Node.Index),
+      Name:      item.Name,
+      Output:    item.Output,
+      AllowTags: item.AllowTags,
+      Match:     item.Match,
+      Metadata:  item.Metadata,
     }
+    field.Node = srv.MapHtmlExtractNode(item.Node)
 
     if len(item.Fields) > 0 {
       field.Fields = srv.MapHtmlExtractField(item.Fields)
@@ -209,18 +341,106 @@ func (srv *Sources) MapHtmlExtractField(items []*pb.HtmlExtractField) []*reposit
       })
     }
 
+    if item.RegexExtract != nil {
+      field.RegexExtract = &repositories.RegexExtract{
+        Pattern: item.RegexExtract.Pattern,
+        Group:   item.RegexExtract.Group,
+        All:     item.RegexExtract.All,
+        Miss:    item.RegexExtract.Miss,
+      }
+    }
+
+    for _, transform := range item.Transforms {
+      field.Transforms = append(field.Transforms, &repositories.Transform{
+        Type:      transform.Type,
+        Layout:    transform.Layout,
+        Timezone:  transform.Timezone,
+        Separator: transform.Separator,
+        Value:     transform.Value,
+      })
+    }
+
     fields = append(fields, field)
   }
 
   return fields
 }
 
+func (srv *Sources) MapHtmlExtractNode(data *pb.HtmlExtractNode) *repositories.HtmlExtractNode {
+  if data == nil {
+    return nil
+  }
+  return &repositories.HtmlExtractNode{
+    Selector:  data.Selector,
+    Attr:      data.Attr,
+    Index:     int(data.Index),
+    Type:      data.Type,
+    Resolve:   data.Resolve,
+    All:       data.All,
+    Fallbacks: data.Fallbacks,
+  }
+}
+
+func (srv *Sources) MapComputedFields(items []*pb.ComputedField) []*repositories.ComputedField {
+  var fields []*repositories.ComputedField
+  for _, item := range items {
+    fields = append(fields, &repositories.ComputedField{
+      Name:     item.Name,
+      Template: item.Template,
+    })
+  }
+  return fields
+}
+
+func (srv *Sources) MapFilters(items []*pb.Filter) []*repositories.Filter {
+  var filters []*repositories.Filter
+  for _, item := range items {
+    filter := &repositories.Filter{
+      Field: item.Field,
+      Op:    item.Op,
+      Value: item.Value,
+      All:   srv.MapFilters(item.All),
+      Any:   srv.MapFilters(item.Any),
+    }
+    if item.Not != nil {
+      filter.Not = srv.MapFilters([]*pb.Filter{item.Not})[0]
+    }
+    filters = append(filters, filter)
+  }
+  return filters
+}
+
+func (srv *Sources) MapArticleExtractRules(data *pb.ArticleExtractRules) *repositories.ArticleExtractRules {
+  return &repositories.ArticleExtractRules{
+    Output:   data.Output,
+    Fields:   srv.MapHtmlExtractField(data.Fields),
+    Computed: srv.MapComputedFields(data.Computed),
+  }
+}
+
+func (srv *Sources) MapScriptExtractRules(data *pb.ScriptExtractRules) *repositories.ScriptExtractRules {
+  rules := &repositories.ScriptExtractRules{
+    Variable: data.Variable,
+  }
+  if data.Node != nil {
+    rules.Node = srv.MapHtmlExtractNode(data.Node)
+  }
+  if data.Json != nil {
+    rules.Json = srv.MapJsonExtractRules(data.Json)
+  }
+  return rules
+}
+
 func (srv *Sources) MapJsonExtractRules(data *pb.JsonExtractRules) *repositories.JsonExtractRules {
   rules := &repositories.JsonExtractRules{
-    Container: data.Container,
-    List:      data.List,
+    Container:          data.Container,
+    ContainerFallbacks: data.ContainerFallbacks,
+    List:               data.List,
+    ListFallbacks:      data.ListFallbacks,
   }
   rules.Fields = srv.MapJsonExtractField(data.Fields)
+  rules.Computed = srv.MapComputedFields(data.Computed)
+  rules.Filters = srv.MapFilters(data.Filters)
   return rules
 }
 
@@ -228,13 +448,98 @@ func (srv *Sources) MapJsonExtractField(items []*pb.JsonExtractField) []*reposit
   var fields []*repositories.JsonExtractField
   for _, item := range items {
     field := &repositories.JsonExtractField{
+      Name:      item.Name,
+      Path:      item.Path,
+      Fallbacks: item.Fallbacks,
+      Match:     item.Match,
+    }
+
+    if len(item.Fields) > 0 {
+      field.Fields = srv.MapJsonExtractField(item.Fields)
+    }
+
+    for _, replace := range item.RegexReplace {
+      field.RegexReplace = append(field.RegexReplace, &repositories.RegexReplace{
+        Pattern: replace.Pattern,
+        Value:   replace.Value,
+      })
+    }
+
+    for _, replace := range item.TextReplace {
+      field.TextReplace = append(field.TextReplace, &repositories.TextReplace{
+        Text:  replace.Text,
+        Value: replace.Value,
+      })
+    }
+
+    if item.RegexExtract != nil {
+      field.RegexExtract = &repositories.RegexExtract{
+        Pattern: item.RegexExtract.Pattern,
+        Group:   item.RegexExtract.Group,
+        All:     item.RegexExtract.All,
+        Miss:    item.RegexExtract.Miss,
+      }
+    }
+
+    for _, transform := range item.Transforms {
+      field.Transforms = append(field.Transforms, &repositories.Transform{
+        Type:      transform.Type,
+        Layout:    transform.Layout,
+        Timezone:  transform.Timezone,
+        Separator: transform.Separator,
+        Value:     transform.Value,
+      })
+    }
+
+    fields = append(fields, field)
+  }
+
+  return fields
+}
+
+func (srv *Sources) MapExtractSchema(data *pb.ExtractSchema) *repositories.ExtractSchema {
+  schema := &repositories.ExtractSchema{
+    Invalid: data.Invalid,
+  }
+  for _, item := range data.Fields {
+    schema.Fields = append(schema.Fields, &repositories.SchemaField{
+      Name:     item.Name,
+      Type:     item.Type,
+      Required: item.Required,
+      Default:  item.Default,
+    })
+  }
+  return schema
+}
+
+func (srv *Sources) MapXmlExtractRules(data *pb.XmlExtractRules) *repositories.XmlExtractRules {
+  rules := &repositories.XmlExtractRules{
+    Preset:    data.Preset,
+    Container: data.Container,
+    List:      data.List,
+  }
+  if len(data.Namespaces) > 0 {
+    rules.Namespaces = map[string]string{}
+    for _, namespace := range data.Namespaces {
+      rules.Namespaces[namespace.Prefix] = namespace.Url
+    }
+  }
+  rules.Fields = srv.MapXmlExtractField(data.Fields)
+  rules.Filters = srv.MapFilters(data.Filters)
+  return rules
+}
+
+func (srv *Sources) MapXmlExtractField(items []*pb.XmlExtractField) []*repositories.XmlExtractField {
+  var fields []*repositories.XmlExtractField
+  for _, item := range items {
+    field := &repositories.XmlExtractField{
       Name:  item.Name,
       Path:  item.Path,
       Match: item.Match,
     }
 
     if len(item.Fields) > 0 {
-      field.Fields = srv.MapJsonExtractField(item.Fields)
+      field.Fields = srv.MapXmlExtractField(item.Fields)
     }
 
     for _, replace := range item.RegexReplace {
@@ -251,6 +556,25 @@ func (srv *Sources) MapJsonExtractField(items []*pb.JsonExtractField) []*reposit
       })
     }
 
+    if item.RegexExtract != nil {
+      field.RegexExtract = &repositories.RegexExtract{
+        Pattern: item.RegexExtract.Pattern,
+        Group:   item.RegexExtract.Group,
+        All:     item.RegexExtract.All,
+        Miss:    item.RegexExtract.Miss,
+      }
+    }
+
+    for _, transform := range item.Transforms {
+      field.Transforms = append(field.Transforms, &repositories.Transform{
+        Type:      transform.Type,
+        Layout:    transform.Layout,
+        Timezone:  transform.Timezone,
+        Separator: transform.Separator,
+        Value:     transform.Value,
+      })
+    }
+
     fields = append(fields, field)
   }
 
@@ -259,7 +583,9 @@ func (srv *Sources) MapJsonExtractField(items []*pb.JsonExtractField) []*reposit
 
 func (srv *Sources) ToExtractRules(name string, data *repositories.ExtractRules) *pb.ExtractRules {
   rules := &pb.ExtractRules{
-    Name: name,
+    Name:     name,
+    Input:    data.Input,
+    Internal: data.Internal,
   }
   if data.Html != nil {
     rules.Html = srv.ToHtmlExtractRules(data.Html)
@@ -267,24 +593,35 @@ func (srv *Sources) ToExtractRules(name string, data *repositories.ExtractRules)
   if data.Json != nil {
     rules.Json = srv.ToJsonExtractRules(data.Json)
   }
+  if data.Script != nil {
+    rules.Script = srv.ToScriptExtractRules(data.Script)
+  }
+  if data.Xml != nil {
+    rules.Xml = srv.ToXmlExtractRules(data.Xml)
+  }
+  if data.Article != nil {
+    rules.Article = srv.ToArticleExtractRules(data.Article)
+  }
+  if data.Metadata != nil {
+    rules.Metadata = &pb.MetadataExtractRules{
+      Include: data.Metadata.Include,
+    }
+  }
+  if data.Schema != nil {
+    rules.Schema = srv.ToExtractSchema(data.Schema)
+  }
   return rules
 }
 
 func (srv *Sources) ToHtmlExtractRules(data *repositories.HtmlExtractRules) *pb.HtmlExtractRules {
   rules := &pb.HtmlExtractRules{}
-  rules.Container = &pb.HtmlExtractNode{
-    Selector: data.Container.Selector,
-    Attr:     data.Container.Attr,
-    Index:    uint32(data.Container.Index),
-  }
+  rules.Container = srv.ToHtmlExtractNode(data.Container)
   if data.List != nil {
-    rules.List = &pb.HtmlExtractNode{
-      Selector: data.Container.Selector,
-      Attr:     data.Container.Attr,
-      Index:    uint32(data.Container.Index),
-    }
+    rules.List = srv.ToHtmlExtractNode(data.List)
   }
   rules.Fields = srv.ToHtmlExtractField(data.Fields)
+  rules.Computed = srv.ToComputedFields(data.Computed)
+  rules.Filters = srv.ToFilters(data.Filters)
 
   return rules
 }
@@ -293,14 +630,13 @@ func (srv *Sources) ToHtmlExtractField(items []*repositories.HtmlExtractField) [
   var fields []*pb.HtmlExtractField
   for _, item := range items {
     field := &pb.HtmlExtractField{
-      Name:  item.Name,
-      Match: item.Match,
-    }
-    field.Node = &pb.HtmlExtractNode{
-      Selector: item.Node.Selector,
-      Attr:     item.Node.Attr,
-      Index:    uint32(item.Node.Index),
+      Name:      item.Name,
+      Output:    item.Output,
+      AllowTags: item.AllowTags,
+      Match:     item.Match,
+      Metadata:  item.Metadata,
     }
+    field.Node = srv.ToHtmlExtractNode(item.Node)
 
     if len(item.Fields) > 0 {
       field.Fields = srv.ToHtmlExtractField(item.Fields)
@@ -320,17 +656,105 @@ func (srv *Sources) ToHtmlExtractField(items []*repositories.HtmlExtractField) [
       })
     }
 
+    if item.RegexExtract != nil {
+      field.RegexExtract = &pb.RegexExtract{
+        Pattern: item.RegexExtract.Pattern,
+        Group:   item.RegexExtract.Group,
+        All:     item.RegexExtract.All,
+        Miss:    item.RegexExtract.Miss,
+      }
+    }
+
+    for _, transform := range item.Transforms {
+      field.Transforms = append(field.Transforms, &pb.Transform{
+        Type:      transform.Type,
+        Layout:    transform.Layout,
+        Timezone:  transform.Timezone,
+        Separator: transform.Separator,
+        Value:     transform.Value,
+      })
+    }
+
     fields = append(fields, field)
   }
   return fields
 }
 
+func (srv *Sources) ToHtmlExtractNode(data *repositories.HtmlExtractNode) *pb.HtmlExtractNode {
+  if data == nil {
+    return nil
+  }
+  return &pb.HtmlExtractNode{
+    Selector:  data.Selector,
+    Attr:      data.Attr,
+    Index:     uint32(data.Index),
+    Type:      data.Type,
+    Resolve:   data.Resolve,
+    All:       data.All,
+    Fallbacks: data.Fallbacks,
+  }
+}
+
+func (srv *Sources) ToComputedFields(items []*repositories.ComputedField) []*pb.ComputedField {
+  var fields []*pb.ComputedField
+  for _, item := range items {
+    fields = append(fields, &pb.ComputedField{
+      Name:     item.Name,
+      Template: item.Template,
+    })
+  }
+  return fields
+}
+
+func (srv *Sources) ToFilters(items []*repositories.Filter) []*pb.Filter {
+  var filters []*pb.Filter
+  for _, item := range items {
+    filter := &pb.Filter{
+      Field: item.Field,
+      Op:    item.Op,
+      Value: item.Value,
+      All:   srv.ToFilters(item.All),
+      Any:   srv.ToFilters(item.Any),
+    }
+    if item.Not != nil {
+      filter.Not = srv.ToFilters([]*repositories.Filter{item.Not})[0]
+    }
+    filters = append(filters, filter)
+  }
+  return filters
+}
+
+func (srv *Sources) ToArticleExtractRules(data *repositories.ArticleExtractRules) *pb.ArticleExtractRules {
+  return &pb.ArticleExtractRules{
+    Output:   data.Output,
+    Fields:   srv.ToHtmlExtractField(data.Fields),
+    Computed: srv.ToComputedFields(data.Computed),
+  }
+}
+
+func (srv *Sources) ToScriptExtractRules(data *repositories.ScriptExtractRules) *pb.ScriptExtractRules {
+  rules := &pb.ScriptExtractRules{
+    Variable: data.Variable,
+  }
+  if data.Node != nil {
+    rules.Node = srv.ToHtmlExtractNode(data.Node)
+  }
+  if data.Json != nil {
+    rules.Json = srv.ToJsonExtractRules(data.Json)
+  }
+  return rules
+}
+
 func (srv *Sources) ToJsonExtractRules(data *repositories.JsonExtractRules) *pb.JsonExtractRules {
   rules := &pb.JsonExtractRules{
-    Container: data.Container,
-    List:      data.List,
+    Container:          data.Container,
+    ContainerFallbacks: data.ContainerFallbacks,
+    List:               data.List,
+    ListFallbacks:      data.ListFallbacks,
   }
   rules.Fields = srv.ToJsonExtractField(data.Fields)
+  rules.Computed = srv.ToComputedFields(data.Computed)
+  rules.Filters = srv.ToFilters(data.Filters)
   return rules
 }
 
@@ -338,13 +762,97 @@ func (srv *Sources) ToJsonExtractField(items []*repositories.JsonExtractField) [
   var fields []*pb.JsonExtractField
   for _, item := range items {
     field := &pb.JsonExtractField{
+      Name:      item.Name,
+      Path:      item.Path,
+      Fallbacks: item.Fallbacks,
+      Match:     item.Match,
+    }
+
+    if len(item.Fields) > 0 {
+      field.Fields = srv.ToJsonExtractField(item.Fields)
+    }
+
+    for _, replace := range item.RegexReplace {
+      field.RegexReplace = append(field.RegexReplace, &pb.RegexReplace{
+        Pattern: replace.Pattern,
+        Value:   replace.Value,
+      })
+    }
+
+    for _, replace := range item.TextReplace {
+      field.TextReplace = append(field.TextReplace, &pb.TextReplace{
+        Text:  replace.Text,
+        Value: replace.Value,
+      })
+    }
+
+    if item.RegexExtract != nil {
+      field.RegexExtract = &pb.RegexExtract{
+        Pattern: item.RegexExtract.Pattern,
+        Group:   item.RegexExtract.Group,
+        All:     item.RegexExtract.All,
+        Miss:    item.RegexExtract.Miss,
+      }
+    }
+
+    for _, transform := range item.Transforms {
+      field.Transforms = append(field.Transforms, &pb.Transform{
+        Type:      transform.Type,
+        Layout:    transform.Layout,
+        Timezone:  transform.Timezone,
+        Separator: transform.Separator,
+        Value:     transform.Value,
+      })
+    }
+
+    fields = append(fields, field)
+  }
+  return fields
+}
+
+func (srv *Sources) ToExtractSchema(data *repositories.ExtractSchema) *pb.ExtractSchema {
+  schema := &pb.ExtractSchema{
+    Invalid: data.Invalid,
+  }
+  for _, item := range data.Fields {
+    schema.Fields = append(schema.Fields, &pb.SchemaField{
+      Name:     item.Name,
+      Type:     item.Type,
+      Required: item.Required,
+      Default:  item.Default,
+    })
+  }
+  return schema
+}
+
+func (srv *Sources) ToXmlExtractRules(data *repositories.XmlExtractRules) *pb.XmlExtractRules {
+  rules := &pb.XmlExtractRules{
+    Preset:    data.Preset,
+    Container: data.Container,
+    List:      data.List,
+  }
+  for prefix, url := range data.Namespaces {
+    rules.Namespaces = append(rules.Namespaces, &pb.XmlNamespace{
+      Prefix: prefix,
+      Url:    url,
+    })
+  }
+  rules.Fields = srv.ToXmlExtractField(data.Fields)
+  rules.Filters = srv.ToFilters(data.Filters)
+  return rules
+}
+
+func (srv *Sources) ToXmlExtractField(items []*repositories.XmlExtractField) []*pb.XmlExtractField {
+  var fields []*pb.XmlExtractField
+  for _, item := range items {
+    field := &pb.XmlExtractField{
       Name:  item.Name,
       Path:  item.Path,
       Match: item.Match,
     }
 
     if len(item.Fields) > 0 {
-      field.Fields = srv.ToJsonExtractField(item.Fields)
+      field.Fields = srv.ToXmlExtractField(item.Fields)
     }
 
     for _, replace := range item.RegexReplace {
@@ -361,6 +869,25 @@ func (srv *Sources) ToJsonExtractField(items []*repositories.JsonExtractField) [
       })
     }
 
+    if item.RegexExtract != nil {
+      field.RegexExtract = &pb.RegexExtract{
+        Pattern: item.RegexExtract.Pattern,
+        Group:   item.RegexExtract.Group,
+        All:     item.RegexExtract.All,
+        Miss:    item.RegexExtract.Miss,
+      }
+    }
+
+    for _, transform := range item.Transforms {
+      field.Transforms = append(field.Transforms, &pb.Transform{
+        Type:      transform.Type,
+        Layout:    transform.Layout,
+        Timezone:  transform.Timezone,
+        Separator: transform.Separator,
+        Value:     transform.Value,
+      })
+    }
+
     fields = append(fields, field)
   }
   return fields
@@ -0,0 +1,93 @@
+package services
+
+import (
+  "strings"
+  "testing"
+
+  pb "taoniu.local/crawls/spiders/grpc/sources"
+  "taoniu.local/crawls/spiders/repositories"
+)
+
+func TestSourcesMapMissingNode(t *testing.T) {
+  srv := &Sources{Repository: &repositories.SourcesRepository{}}
+  extractRules := srv.MapExtractRulesList([]*pb.ExtractRules{
+    {
+      Name: "detail",
+      Html: &pb.HtmlExtractRules{
+        Fields: []*pb.HtmlExtractField{
+          {Name: "title"},
+        },
+      },
+    },
+  })
+
+  err := srv.Repository.ValidateRules(map[string]interface{}{}, extractRules)
+  if err == nil {
+    t.Fatal("validate rules: expected error")
+  }
+  for _, message := range []string{
+    "extract_rules.detail.html.container: selector is required",
+    "extract_rules.detail.html.fields[0].node: node is required",
+  } {
+    if !strings.Contains(err.Error(), message) {
+      t.Errorf("validate rules: %q missing from %q", message, err.Error())
+    }
+  }
+}
+
+func TestSourcesMapParams(t *testing.T) {
+  srv := &Sources{}
+  params := srv.MapParams(&pb.Params{
+    Scroll:   "articles.#.createtime",
+    Timezone: "Asia/Shanghai",
+    Charset:  "gbk",
+  })
+  if params["timezone"] != "Asia/Shanghai" {
+    t.Errorf("timezone: got %v", params["timezone"])
+  }
+  if params["charset"] != "gbk" {
+    t.Errorf("charset: got %v", params["charset"])
+  }
+  params = srv.MapParams(&pb.Params{})
+  for _, name := range []string{"timezone", "charset"} {
+    if _, ok := params[name]; ok {
+      t.Errorf("%s: expected empty value to be omitted", name)
+    }
+  }
+}
+
+func TestSourcesToMissingNode(t *testing.T) {
+  srv := &Sources{}
+  rules := srv.ToExtractRules("detail", &repositories.ExtractRules{
+    Html: &repositories.HtmlExtractRules{
+      Container: &repositories.HtmlExtractNode{Selector: "article"},
+      Fields: []*repositories.HtmlExtractField{
+        {Name: "title", Node: &repositories.HtmlExtractNode{Selector: "h1"}},
+        {Name: "summary", Metadata: "opengraph.description"},
+      },
+    },
+  })
+  if rules.Html.List != nil {
+    t.Errorf("list: got %v, want nil", rules.Html.List)
+  }
+  if rules.Html.Fields[0].Node.Selector != "h1" || rules.Html.Fields[1].Node != nil {
+    t.Errorf("fields: got %v", rules.Html.Fields)
+  }
+}
+
+func TestSourcesMapJsonFallbacks(t *testing.T) {
+  srv := &Sources{}
+  rules := srv.MapJsonExtractRules(&pb.JsonExtractRules{
+    Container:          "data",
+    ContainerFallbacks: []string{"result"},
+    List:               "items",
+    ListFallbacks:      []string{"list"},
+  })
+  if len(rules.ContainerFallbacks) != 1 || rules.ContainerFallbacks[0] != "result" || len(rules.ListFallbacks) != 1 || rules.ListFallbacks[0] != "list" {
+    t.Errorf("map json fallbacks: got %+v", rules)
+  }
+  message := srv.ToJsonExtractRules(rules)
+  if len(message.ContainerFallbacks) != 1 || len(message.ListFallbacks) != 1 {
+    t.Errorf("to json fallbacks: got %+v", message)
+  }
+}
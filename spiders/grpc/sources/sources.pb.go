@@ -259,6 +259,9 @@ type SourceInfo struct {
 	Status        int32                `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt     *timestamp.Timestamp `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt     *timestamp.Timestamp `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Method        string               `protobuf:"bytes,14,opt,name=method,proto3" json:"method,omitempty"`
+	Body          string               `protobuf:"bytes,15,opt,name=body,proto3" json:"body,omitempty"`
+	ContentType   string               `protobuf:"bytes,16,opt,name=contentType,proto3" json:"contentType,omitempty"`
 }
 
 func (x *SourceInfo) Reset() {
@@ -384,6 +387,27 @@ func (x *SourceInfo) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *SourceInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SourceInfo) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SourceInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 type SaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -398,6 +422,9 @@ type SaveRequest struct {
 	ExtractRules []*ExtractRules `protobuf:"bytes,7,rep,name=extractRules,proto3" json:"extractRules,omitempty"`
 	UseProxy     bool            `protobuf:"varint,8,opt,name=useProxy,proto3" json:"useProxy,omitempty"`
 	Timeout      uint32          `protobuf:"varint,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Method       string          `protobuf:"bytes,10,opt,name=method,proto3" json:"method,omitempty"`
+	Body         string          `protobuf:"bytes,11,opt,name=body,proto3" json:"body,omitempty"`
+	ContentType  string          `protobuf:"bytes,12,opt,name=contentType,proto3" json:"contentType,omitempty"`
 }
 
 func (x *SaveRequest) Reset() {
@@ -495,6 +522,27 @@ func (x *SaveRequest) GetTimeout() uint32 {
 	return 0
 }
 
+func (x *SaveRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SaveRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SaveRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 type HttpHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -555,9 +603,14 @@ type Params struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Split  []*Split     `protobuf:"bytes,1,rep,name=split,proto3" json:"split,omitempty"`
-	Scroll string       `protobuf:"bytes,2,opt,name=scroll,proto3" json:"scroll,omitempty"`
-	Query  []*HttpQuery `protobuf:"bytes,3,rep,name=query,proto3" json:"query,omitempty"`
+	Split        []*Split     `protobuf:"bytes,1,rep,name=split,proto3" json:"split,omitempty"`
+	Scroll       string       `protobuf:"bytes,2,opt,name=scroll,proto3" json:"scroll,omitempty"`
+	Query        []*HttpQuery `protobuf:"bytes,3,rep,name=query,proto3" json:"query,omitempty"`
+	Proxy        *ProxyParams `protobuf:"bytes,4,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Limit        *RateLimit   `protobuf:"bytes,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	IgnoreRobots bool         `protobuf:"varint,6,opt,name=ignoreRobots,proto3" json:"ignoreRobots,omitempty"`
+	Timezone     string       `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Charset      string       `protobuf:"bytes,8,opt,name=charset,proto3" json:"charset,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -613,17 +666,53 @@ func (x *Params) GetQuery() []*HttpQuery {
 	return nil
 }
 
-type Split struct {
+func (x *Params) GetProxy() *ProxyParams {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *Params) GetLimit() *RateLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *Params) GetIgnoreRobots() bool {
+	if x != nil {
+		return x.IgnoreRobots
+	}
+	return false
+}
+
+func (x *Params) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Params) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+type RateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Parent string   `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	Path   []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	Rate        float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Concurrency uint32  `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Jitter      uint32  `protobuf:"varint,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
 }
 
-func (x *Split) Reset() {
-	*x = Split{}
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sources_sources_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,13 +720,13 @@ func (x *Split) Reset() {
 	}
 }
 
-func (x *Split) String() string {
+func (x *RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Split) ProtoMessage() {}
+func (*RateLimit) ProtoMessage() {}
 
-func (x *Split) ProtoReflect() protoreflect.Message {
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_sources_sources_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,37 +738,44 @@ func (x *Split) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Split.ProtoReflect.Descriptor instead.
-func (*Split) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
 	return file_sources_sources_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Split) GetParent() string {
+func (x *RateLimit) GetRate() float64 {
 	if x != nil {
-		return x.Parent
+		return x.Rate
 	}
-	return ""
+	return 0
 }
 
-func (x *Split) GetPath() []string {
+func (x *RateLimit) GetConcurrency() uint32 {
 	if x != nil {
-		return x.Path
+		return x.Concurrency
 	}
-	return nil
+	return 0
 }
 
-type HttpQuery struct {
+func (x *RateLimit) GetJitter() uint32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+type ProxyParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Default string `protobuf:"bytes,3,opt,name=default,proto3" json:"default,omitempty"`
+	Pool     string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Tag      string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Rotation string `protobuf:"bytes,3,opt,name=rotation,proto3" json:"rotation,omitempty"`
 }
 
-func (x *HttpQuery) Reset() {
-	*x = HttpQuery{}
+func (x *ProxyParams) Reset() {
+	*x = ProxyParams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sources_sources_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -687,13 +783,13 @@ func (x *HttpQuery) Reset() {
 	}
 }
 
-func (x *HttpQuery) String() string {
+func (x *ProxyParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HttpQuery) ProtoMessage() {}
+func (*ProxyParams) ProtoMessage() {}
 
-func (x *HttpQuery) ProtoReflect() protoreflect.Message {
+func (x *ProxyParams) ProtoReflect() protoreflect.Message {
 	mi := &file_sources_sources_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -705,43 +801,43 @@ func (x *HttpQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HttpQuery.ProtoReflect.Descriptor instead.
-func (*HttpQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProxyParams.ProtoReflect.Descriptor instead.
+func (*ProxyParams) Descriptor() ([]byte, []int) {
 	return file_sources_sources_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *HttpQuery) GetName() string {
+func (x *ProxyParams) GetPool() string {
 	if x != nil {
-		return x.Name
+		return x.Pool
 	}
 	return ""
 }
 
-func (x *HttpQuery) GetValue() string {
+func (x *ProxyParams) GetTag() string {
 	if x != nil {
-		return x.Value
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *HttpQuery) GetDefault() string {
+func (x *ProxyParams) GetRotation() string {
 	if x != nil {
-		return x.Default
+		return x.Rotation
 	}
 	return ""
 }
 
-type SaveReply struct {
+type Split struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Parent string   `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Path   []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *SaveReply) Reset() {
-	*x = SaveReply{}
+func (x *Split) Reset() {
+	*x = Split{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sources_sources_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -749,13 +845,13 @@ func (x *SaveReply) Reset() {
 	}
 }
 
-func (x *SaveReply) String() string {
+func (x *Split) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveReply) ProtoMessage() {}
+func (*Split) ProtoMessage() {}
 
-func (x *SaveReply) ProtoReflect() protoreflect.Message {
+func (x *Split) ProtoReflect() protoreflect.Message {
 	mi := &file_sources_sources_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -767,37 +863,37 @@ func (x *SaveReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveReply.ProtoReflect.Descriptor instead.
-func (*SaveReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use Split.ProtoReflect.Descriptor instead.
+func (*Split) Descriptor() ([]byte, []int) {
 	return file_sources_sources_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SaveReply) GetSuccess() bool {
+func (x *Split) GetParent() string {
 	if x != nil {
-		return x.Success
+		return x.Parent
 	}
-	return false
+	return ""
 }
 
-func (x *SaveReply) GetMessage() string {
+func (x *Split) GetPath() []string {
 	if x != nil {
-		return x.Message
+		return x.Path
 	}
-	return ""
+	return nil
 }
 
-type ExtractRules struct {
+type HttpQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Html *HtmlExtractRules `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
-	Json *JsonExtractRules `protobuf:"bytes,3,opt,name=json,proto3" json:"json,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Default string `protobuf:"bytes,3,opt,name=default,proto3" json:"default,omitempty"`
 }
 
-func (x *ExtractRules) Reset() {
-	*x = ExtractRules{}
+func (x *HttpQuery) Reset() {
+	*x = HttpQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sources_sources_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,13 +901,13 @@ func (x *ExtractRules) Reset() {
 	}
 }
 
-func (x *ExtractRules) String() string {
+func (x *HttpQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtractRules) ProtoMessage() {}
+func (*HttpQuery) ProtoMessage() {}
 
-func (x *ExtractRules) ProtoReflect() protoreflect.Message {
+func (x *HttpQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_sources_sources_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -823,44 +919,43 @@ func (x *ExtractRules) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtractRules.ProtoReflect.Descriptor instead.
-func (*ExtractRules) Descriptor() ([]byte, []int) {
+// Deprecated: Use HttpQuery.ProtoReflect.Descriptor instead.
+func (*HttpQuery) Descriptor() ([]byte, []int) {
 	return file_sources_sources_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ExtractRules) GetName() string {
+func (x *HttpQuery) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ExtractRules) GetHtml() *HtmlExtractRules {
+func (x *HttpQuery) GetValue() string {
 	if x != nil {
-		return x.Html
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-func (x *ExtractRules) GetJson() *JsonExtractRules {
+func (x *HttpQuery) GetDefault() string {
 	if x != nil {
-		return x.Json
+		return x.Default
 	}
-	return nil
+	return ""
 }
 
-type ExtractResult struct {
+type SaveReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Data  []*ExtractResult `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ExtractResult) Reset() {
-	*x = ExtractResult{}
+func (x *SaveReply) Reset() {
+	*x = SaveReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sources_sources_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -868,13 +963,13 @@ func (x *ExtractResult) Reset() {
 	}
 }
 
-func (x *ExtractResult) String() string {
+func (x *SaveReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtractResult) ProtoMessage() {}
+func (*SaveReply) ProtoMessage() {}
 
-func (x *ExtractResult) ProtoReflect() protoreflect.Message {
+func (x *SaveReply) ProtoReflect() protoreflect.Message {
 	mi := &file_sources_sources_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -886,44 +981,38 @@ func (x *ExtractResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtractResult.ProtoReflect.Descriptor instead.
-func (*ExtractResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveReply.ProtoReflect.Descriptor instead.
+func (*SaveReply) Descriptor() ([]byte, []int) {
 	return file_sources_sources_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ExtractResult) GetName() string {
+func (x *SaveReply) GetSuccess() bool {
 	if x != nil {
-		return x.Name
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ExtractResult) GetValue() string {
+func (x *SaveReply) GetMessage() string {
 	if x != nil {
-		return x.Value
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ExtractResult) GetData() []*ExtractResult {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type HtmlExtractRules struct {
+type PreviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Container *HtmlExtractNode    `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
-	List      *HtmlExtractNode    `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
-	Fields    []*HtmlExtractField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Slug    string       `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Source  *SaveRequest `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Url     string       `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Content string       `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *HtmlExtractRules) Reset() {
-	*x = HtmlExtractRules{}
+func (x *PreviewRequest) Reset() {
+	*x = PreviewRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sources_sources_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,13 +1020,13 @@ func (x *HtmlExtractRules) Reset() {
 	}
 }
 
-func (x *HtmlExtractRules) String() string {
+func (x *PreviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HtmlExtractRules) ProtoMessage() {}
+func (*PreviewRequest) ProtoMessage() {}
 
-func (x *HtmlExtractRules) ProtoReflect() protoreflect.Message {
+func (x *PreviewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sources_sources_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,44 +1038,55 @@ func (x *HtmlExtractRules) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HtmlExtractRules.ProtoReflect.Descriptor instead.
-func (*HtmlExtractRules) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRequest) Descriptor() ([]byte, []int) {
 	return file_sources_sources_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *HtmlExtractRules) GetContainer() *HtmlExtractNode {
+func (x *PreviewRequest) GetSlug() string {
 	if x != nil {
-		return x.Container
+		return x.Slug
 	}
-	return nil
+	return ""
 }
 
-func (x *HtmlExtractRules) GetList() *HtmlExtractNode {
+func (x *PreviewRequest) GetSource() *SaveRequest {
 	if x != nil {
-		return x.List
+		return x.Source
 	}
 	return nil
 }
 
-func (x *HtmlExtractRules) GetFields() []*HtmlExtractField {
+func (x *PreviewRequest) GetUrl() string {
 	if x != nil {
-		return x.Fields
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-type HtmlExtractNode struct {
+func (x *PreviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type PreviewReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
-	Attr     string `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
-	Index    uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Success       bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Result        string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Rejected      string   `protobuf:"bytes,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	RejectedCount uint32   `protobuf:"varint,5,opt,name=rejectedCount,proto3" json:"rejectedCount,omitempty"`
+	Urls          []string `protobuf:"bytes,6,rep,name=urls,proto3" json:"urls,omitempty"`
+	Matches       string   `protobuf:"bytes,7,opt,name=matches,proto3" json:"matches,omitempty"`
 }
 
-func (x *HtmlExtractNode) Reset() {
-	*x = HtmlExtractNode{}
+func (x *PreviewReply) Reset() {
+	*x = PreviewReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sources_sources_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -994,13 +1094,13 @@ func (x *HtmlExtractNode) Reset() {
 	}
 }
 
-func (x *HtmlExtractNode) String() string {
+func (x *PreviewReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HtmlExtractNode) ProtoMessage() {}
+func (*PreviewReply) ProtoMessage() {}
 
-func (x *HtmlExtractNode) ProtoReflect() protoreflect.Message {
+func (x *PreviewReply) ProtoReflect() protoreflect.Message {
 	mi := &file_sources_sources_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,62 +1112,1319 @@ func (x *HtmlExtractNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HtmlExtractNode.ProtoReflect.Descriptor instead.
-func (*HtmlExtractNode) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewReply.ProtoReflect.Descriptor instead.
+func (*PreviewReply) Descriptor() ([]byte, []int) {
 	return file_sources_sources_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *HtmlExtractNode) GetSelector() string {
+func (x *PreviewReply) GetSuccess() bool {
 	if x != nil {
-		return x.Selector
+		return x.Success
+	}
+	return false
+}
+
+func (x *PreviewReply) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *HtmlExtractNode) GetAttr() string {
+func (x *PreviewReply) GetResult() string {
 	if x != nil {
-		return x.Attr
+		return x.Result
 	}
 	return ""
 }
 
-func (x *HtmlExtractNode) GetIndex() uint32 {
+func (x *PreviewReply) GetRejected() string {
 	if x != nil {
-		return x.Index
+		return x.Rejected
+	}
+	return ""
+}
+
+func (x *PreviewReply) GetRejectedCount() uint32 {
+	if x != nil {
+		return x.RejectedCount
 	}
 	return 0
 }
 
-type HtmlExtractField struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PreviewReply) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *PreviewReply) GetMatches() string {
+	if x != nil {
+		return x.Matches
+	}
+	return ""
+}
+
+type ExtractRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Html     *HtmlExtractRules     `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
+	Json     *JsonExtractRules     `protobuf:"bytes,3,opt,name=json,proto3" json:"json,omitempty"`
+	Script   *ScriptExtractRules   `protobuf:"bytes,4,opt,name=script,proto3" json:"script,omitempty"`
+	Xml      *XmlExtractRules      `protobuf:"bytes,5,opt,name=xml,proto3" json:"xml,omitempty"`
+	Schema   *ExtractSchema        `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	Input    string                `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Internal bool                  `protobuf:"varint,8,opt,name=internal,proto3" json:"internal,omitempty"`
+	Article  *ArticleExtractRules  `protobuf:"bytes,9,opt,name=article,proto3" json:"article,omitempty"`
+	Metadata *MetadataExtractRules `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ExtractRules) Reset() {
+	*x = ExtractRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractRules) ProtoMessage() {}
+
+func (x *ExtractRules) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractRules.ProtoReflect.Descriptor instead.
+func (*ExtractRules) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExtractRules) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtractRules) GetHtml() *HtmlExtractRules {
+	if x != nil {
+		return x.Html
+	}
+	return nil
+}
+
+func (x *ExtractRules) GetJson() *JsonExtractRules {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *ExtractRules) GetScript() *ScriptExtractRules {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *ExtractRules) GetXml() *XmlExtractRules {
+	if x != nil {
+		return x.Xml
+	}
+	return nil
+}
+
+func (x *ExtractRules) GetSchema() *ExtractSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *ExtractRules) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *ExtractRules) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *ExtractRules) GetArticle() *ArticleExtractRules {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *ExtractRules) GetMetadata() *MetadataExtractRules {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ExtractResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Data  []*ExtractResult `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExtractResult) Reset() {
+	*x = ExtractResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtractResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractResult) ProtoMessage() {}
+
+func (x *ExtractResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractResult.ProtoReflect.Descriptor instead.
+func (*ExtractResult) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExtractResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtractResult) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ExtractResult) GetData() []*ExtractResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type HtmlExtractRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container *HtmlExtractNode    `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	List      *HtmlExtractNode    `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	Fields    []*HtmlExtractField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Filters   []*Filter           `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+	Computed  []*ComputedField    `protobuf:"bytes,5,rep,name=computed,proto3" json:"computed,omitempty"`
+}
+
+func (x *HtmlExtractRules) Reset() {
+	*x = HtmlExtractRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HtmlExtractRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HtmlExtractRules) ProtoMessage() {}
+
+func (x *HtmlExtractRules) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HtmlExtractRules.ProtoReflect.Descriptor instead.
+func (*HtmlExtractRules) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HtmlExtractRules) GetContainer() *HtmlExtractNode {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *HtmlExtractRules) GetList() *HtmlExtractNode {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *HtmlExtractRules) GetFields() []*HtmlExtractField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *HtmlExtractRules) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *HtmlExtractRules) GetComputed() []*ComputedField {
+	if x != nil {
+		return x.Computed
+	}
+	return nil
+}
+
+type HtmlExtractNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selector  string   `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Attr      string   `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
+	Index     uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Type      string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Resolve   bool     `protobuf:"varint,5,opt,name=resolve,proto3" json:"resolve,omitempty"`
+	All       bool     `protobuf:"varint,6,opt,name=all,proto3" json:"all,omitempty"`
+	Fallbacks []string `protobuf:"bytes,7,rep,name=fallbacks,proto3" json:"fallbacks,omitempty"`
+}
+
+func (x *HtmlExtractNode) Reset() {
+	*x = HtmlExtractNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HtmlExtractNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HtmlExtractNode) ProtoMessage() {}
+
+func (x *HtmlExtractNode) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HtmlExtractNode.ProtoReflect.Descriptor instead.
+func (*HtmlExtractNode) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HtmlExtractNode) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *HtmlExtractNode) GetAttr() string {
+	if x != nil {
+		return x.Attr
+	}
+	return ""
+}
+
+func (x *HtmlExtractNode) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *HtmlExtractNode) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HtmlExtractNode) GetResolve() bool {
+	if x != nil {
+		return x.Resolve
+	}
+	return false
+}
+
+func (x *HtmlExtractNode) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *HtmlExtractNode) GetFallbacks() []string {
+	if x != nil {
+		return x.Fallbacks
+	}
+	return nil
+}
+
+type HtmlExtractField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node         *HtmlExtractNode    `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Match        string              `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	RegexReplace []*RegexReplace     `protobuf:"bytes,4,rep,name=regexReplace,proto3" json:"regexReplace,omitempty"`
+	TextReplace  []*TextReplace      `protobuf:"bytes,5,rep,name=textReplace,proto3" json:"textReplace,omitempty"`
+	Fields       []*HtmlExtractField `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Output       string              `protobuf:"bytes,7,opt,name=output,proto3" json:"output,omitempty"`
+	AllowTags    []string            `protobuf:"bytes,8,rep,name=allowTags,proto3" json:"allowTags,omitempty"`
+	Transforms   []*Transform        `protobuf:"bytes,9,rep,name=transforms,proto3" json:"transforms,omitempty"`
+	RegexExtract *RegexExtract       `protobuf:"bytes,10,opt,name=regexExtract,proto3" json:"regexExtract,omitempty"`
+	Metadata     string              `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *HtmlExtractField) Reset() {
+	*x = HtmlExtractField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HtmlExtractField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HtmlExtractField) ProtoMessage() {}
+
+func (x *HtmlExtractField) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HtmlExtractField.ProtoReflect.Descriptor instead.
+func (*HtmlExtractField) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HtmlExtractField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HtmlExtractField) GetNode() *HtmlExtractNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *HtmlExtractField) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *HtmlExtractField) GetRegexReplace() []*RegexReplace {
+	if x != nil {
+		return x.RegexReplace
+	}
+	return nil
+}
+
+func (x *HtmlExtractField) GetTextReplace() []*TextReplace {
+	if x != nil {
+		return x.TextReplace
+	}
+	return nil
+}
+
+func (x *HtmlExtractField) GetFields() []*HtmlExtractField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *HtmlExtractField) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *HtmlExtractField) GetAllowTags() []string {
+	if x != nil {
+		return x.AllowTags
+	}
+	return nil
+}
+
+func (x *HtmlExtractField) GetTransforms() []*Transform {
+	if x != nil {
+		return x.Transforms
+	}
+	return nil
+}
+
+func (x *HtmlExtractField) GetRegexExtract() *RegexExtract {
+	if x != nil {
+		return x.RegexExtract
+	}
+	return nil
+}
+
+func (x *HtmlExtractField) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+type ArticleExtractRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output   string              `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Fields   []*HtmlExtractField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Computed []*ComputedField    `protobuf:"bytes,3,rep,name=computed,proto3" json:"computed,omitempty"`
+}
+
+func (x *ArticleExtractRules) Reset() {
+	*x = ArticleExtractRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArticleExtractRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleExtractRules) ProtoMessage() {}
+
+func (x *ArticleExtractRules) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleExtractRules.ProtoReflect.Descriptor instead.
+func (*ArticleExtractRules) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArticleExtractRules) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ArticleExtractRules) GetFields() []*HtmlExtractField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ArticleExtractRules) GetComputed() []*ComputedField {
+	if x != nil {
+		return x.Computed
+	}
+	return nil
+}
+
+type MetadataExtractRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Include []string `protobuf:"bytes,1,rep,name=include,proto3" json:"include,omitempty"`
+}
+
+func (x *MetadataExtractRules) Reset() {
+	*x = MetadataExtractRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetadataExtractRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataExtractRules) ProtoMessage() {}
+
+func (x *MetadataExtractRules) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataExtractRules.ProtoReflect.Descriptor instead.
+func (*MetadataExtractRules) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MetadataExtractRules) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+type ScriptExtractRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node     *HtmlExtractNode  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Variable string            `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	Json     *JsonExtractRules `protobuf:"bytes,3,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *ScriptExtractRules) Reset() {
+	*x = ScriptExtractRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptExtractRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptExtractRules) ProtoMessage() {}
+
+func (x *ScriptExtractRules) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptExtractRules.ProtoReflect.Descriptor instead.
+func (*ScriptExtractRules) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScriptExtractRules) GetNode() *HtmlExtractNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ScriptExtractRules) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *ScriptExtractRules) GetJson() *JsonExtractRules {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+type JsonExtractRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container          string              `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	List               string              `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	Fields             []*JsonExtractField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Filters            []*Filter           `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty"`
+	Computed           []*ComputedField    `protobuf:"bytes,5,rep,name=computed,proto3" json:"computed,omitempty"`
+	ContainerFallbacks []string            `protobuf:"bytes,6,rep,name=containerFallbacks,proto3" json:"containerFallbacks,omitempty"`
+	ListFallbacks      []string            `protobuf:"bytes,7,rep,name=listFallbacks,proto3" json:"listFallbacks,omitempty"`
+}
+
+func (x *JsonExtractRules) Reset() {
+	*x = JsonExtractRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JsonExtractRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonExtractRules) ProtoMessage() {}
+
+func (x *JsonExtractRules) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonExtractRules.ProtoReflect.Descriptor instead.
+func (*JsonExtractRules) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *JsonExtractRules) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *JsonExtractRules) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *JsonExtractRules) GetFields() []*JsonExtractField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *JsonExtractRules) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *JsonExtractRules) GetComputed() []*ComputedField {
+	if x != nil {
+		return x.Computed
+	}
+	return nil
+}
+
+func (x *JsonExtractRules) GetContainerFallbacks() []string {
+	if x != nil {
+		return x.ContainerFallbacks
+	}
+	return nil
+}
+
+func (x *JsonExtractRules) GetListFallbacks() []string {
+	if x != nil {
+		return x.ListFallbacks
+	}
+	return nil
+}
+
+type JsonExtractField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path         string              `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Match        string              `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	RegexReplace []*RegexReplace     `protobuf:"bytes,4,rep,name=regexReplace,proto3" json:"regexReplace,omitempty"`
+	TextReplace  []*TextReplace      `protobuf:"bytes,5,rep,name=textReplace,proto3" json:"textReplace,omitempty"`
+	Fields       []*JsonExtractField `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Transforms   []*Transform        `protobuf:"bytes,7,rep,name=transforms,proto3" json:"transforms,omitempty"`
+	RegexExtract *RegexExtract       `protobuf:"bytes,8,opt,name=regexExtract,proto3" json:"regexExtract,omitempty"`
+	Fallbacks    []string            `protobuf:"bytes,9,rep,name=fallbacks,proto3" json:"fallbacks,omitempty"`
+}
+
+func (x *JsonExtractField) Reset() {
+	*x = JsonExtractField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JsonExtractField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonExtractField) ProtoMessage() {}
+
+func (x *JsonExtractField) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonExtractField.ProtoReflect.Descriptor instead.
+func (*JsonExtractField) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *JsonExtractField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JsonExtractField) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JsonExtractField) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *JsonExtractField) GetRegexReplace() []*RegexReplace {
+	if x != nil {
+		return x.RegexReplace
+	}
+	return nil
+}
+
+func (x *JsonExtractField) GetTextReplace() []*TextReplace {
+	if x != nil {
+		return x.TextReplace
+	}
+	return nil
+}
+
+func (x *JsonExtractField) GetFields() []*JsonExtractField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *JsonExtractField) GetTransforms() []*Transform {
+	if x != nil {
+		return x.Transforms
+	}
+	return nil
+}
+
+func (x *JsonExtractField) GetRegexExtract() *RegexExtract {
+	if x != nil {
+		return x.RegexExtract
+	}
+	return nil
+}
+
+func (x *JsonExtractField) GetFallbacks() []string {
+	if x != nil {
+		return x.Fallbacks
+	}
+	return nil
+}
+
+type XmlExtractRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preset     string             `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	Namespaces []*XmlNamespace    `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Container  string             `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	List       string             `protobuf:"bytes,4,opt,name=list,proto3" json:"list,omitempty"`
+	Fields     []*XmlExtractField `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	Filters    []*Filter          `protobuf:"bytes,6,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *XmlExtractRules) Reset() {
+	*x = XmlExtractRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XmlExtractRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XmlExtractRules) ProtoMessage() {}
+
+func (x *XmlExtractRules) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XmlExtractRules.ProtoReflect.Descriptor instead.
+func (*XmlExtractRules) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *XmlExtractRules) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *XmlExtractRules) GetNamespaces() []*XmlNamespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *XmlExtractRules) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *XmlExtractRules) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *XmlExtractRules) GetFields() []*XmlExtractField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *XmlExtractRules) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type XmlNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Url    string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *XmlNamespace) Reset() {
+	*x = XmlNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XmlNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XmlNamespace) ProtoMessage() {}
+
+func (x *XmlNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XmlNamespace.ProtoReflect.Descriptor instead.
+func (*XmlNamespace) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *XmlNamespace) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *XmlNamespace) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type XmlExtractField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path         string             `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Match        string             `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	RegexReplace []*RegexReplace    `protobuf:"bytes,4,rep,name=regexReplace,proto3" json:"regexReplace,omitempty"`
+	TextReplace  []*TextReplace     `protobuf:"bytes,5,rep,name=textReplace,proto3" json:"textReplace,omitempty"`
+	Fields       []*XmlExtractField `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Transforms   []*Transform       `protobuf:"bytes,7,rep,name=transforms,proto3" json:"transforms,omitempty"`
+	RegexExtract *RegexExtract      `protobuf:"bytes,8,opt,name=regexExtract,proto3" json:"regexExtract,omitempty"`
+}
+
+func (x *XmlExtractField) Reset() {
+	*x = XmlExtractField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XmlExtractField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XmlExtractField) ProtoMessage() {}
+
+func (x *XmlExtractField) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XmlExtractField.ProtoReflect.Descriptor instead.
+func (*XmlExtractField) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *XmlExtractField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *XmlExtractField) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *XmlExtractField) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *XmlExtractField) GetRegexReplace() []*RegexReplace {
+	if x != nil {
+		return x.RegexReplace
+	}
+	return nil
+}
+
+func (x *XmlExtractField) GetTextReplace() []*TextReplace {
+	if x != nil {
+		return x.TextReplace
+	}
+	return nil
+}
+
+func (x *XmlExtractField) GetFields() []*XmlExtractField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *XmlExtractField) GetTransforms() []*Transform {
+	if x != nil {
+		return x.Transforms
+	}
+	return nil
+}
+
+func (x *XmlExtractField) GetRegexExtract() *RegexExtract {
+	if x != nil {
+		return x.RegexExtract
+	}
+	return nil
+}
+
+type RegexReplace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RegexReplace) Reset() {
+	*x = RegexReplace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegexReplace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexReplace) ProtoMessage() {}
+
+func (x *RegexReplace) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexReplace.ProtoReflect.Descriptor instead.
+func (*RegexReplace) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RegexReplace) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *RegexReplace) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type RegexExtract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Group   string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	All     bool   `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	Miss    string `protobuf:"bytes,4,opt,name=miss,proto3" json:"miss,omitempty"`
+}
+
+func (x *RegexExtract) Reset() {
+	*x = RegexExtract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegexExtract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexExtract) ProtoMessage() {}
+
+func (x *RegexExtract) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexExtract.ProtoReflect.Descriptor instead.
+func (*RegexExtract) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RegexExtract) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *RegexExtract) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *RegexExtract) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *RegexExtract) GetMiss() string {
+	if x != nil {
+		return x.Miss
+	}
+	return ""
+}
 
-	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Node         *HtmlExtractNode    `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
-	Match        string              `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
-	RegexReplace []*RegexReplace     `protobuf:"bytes,4,rep,name=regexReplace,proto3" json:"regexReplace,omitempty"`
-	TextReplace  []*TextReplace      `protobuf:"bytes,5,rep,name=textReplace,proto3" json:"textReplace,omitempty"`
-	Fields       []*HtmlExtractField `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+type TextReplace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text  string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *HtmlExtractField) Reset() {
-	*x = HtmlExtractField{}
+func (x *TextReplace) Reset() {
+	*x = TextReplace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sources_sources_proto_msgTypes[15]
+		mi := &file_sources_sources_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HtmlExtractField) String() string {
+func (x *TextReplace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HtmlExtractField) ProtoMessage() {}
+func (*TextReplace) ProtoMessage() {}
 
-func (x *HtmlExtractField) ProtoReflect() protoreflect.Message {
-	mi := &file_sources_sources_proto_msgTypes[15]
+func (x *TextReplace) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,80 +2435,130 @@ func (x *HtmlExtractField) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HtmlExtractField.ProtoReflect.Descriptor instead.
-func (*HtmlExtractField) Descriptor() ([]byte, []int) {
-	return file_sources_sources_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use TextReplace.ProtoReflect.Descriptor instead.
+func (*TextReplace) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *HtmlExtractField) GetName() string {
+func (x *TextReplace) GetText() string {
 	if x != nil {
-		return x.Name
+		return x.Text
 	}
 	return ""
 }
 
-func (x *HtmlExtractField) GetNode() *HtmlExtractNode {
+func (x *TextReplace) GetValue() string {
 	if x != nil {
-		return x.Node
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-func (x *HtmlExtractField) GetMatch() string {
+type Transform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Layout    string `protobuf:"bytes,2,opt,name=layout,proto3" json:"layout,omitempty"`
+	Timezone  string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Separator string `protobuf:"bytes,4,opt,name=separator,proto3" json:"separator,omitempty"`
+	Value     string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Transform) Reset() {
+	*x = Transform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sources_sources_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transform) ProtoMessage() {}
+
+func (x *Transform) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transform.ProtoReflect.Descriptor instead.
+func (*Transform) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Transform) GetType() string {
 	if x != nil {
-		return x.Match
+		return x.Type
 	}
 	return ""
 }
 
-func (x *HtmlExtractField) GetRegexReplace() []*RegexReplace {
+func (x *Transform) GetLayout() string {
 	if x != nil {
-		return x.RegexReplace
+		return x.Layout
 	}
-	return nil
+	return ""
 }
 
-func (x *HtmlExtractField) GetTextReplace() []*TextReplace {
+func (x *Transform) GetTimezone() string {
 	if x != nil {
-		return x.TextReplace
+		return x.Timezone
 	}
-	return nil
+	return ""
 }
 
-func (x *HtmlExtractField) GetFields() []*HtmlExtractField {
+func (x *Transform) GetSeparator() string {
 	if x != nil {
-		return x.Fields
+		return x.Separator
 	}
-	return nil
+	return ""
 }
 
-type JsonExtractRules struct {
+func (x *Transform) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ExtractSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Container string              `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
-	List      string              `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
-	Fields    []*JsonExtractField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Fields  []*SchemaField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Invalid string         `protobuf:"bytes,2,opt,name=invalid,proto3" json:"invalid,omitempty"`
 }
 
-func (x *JsonExtractRules) Reset() {
-	*x = JsonExtractRules{}
+func (x *ExtractSchema) Reset() {
+	*x = ExtractSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sources_sources_proto_msgTypes[16]
+		mi := &file_sources_sources_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JsonExtractRules) String() string {
+func (x *ExtractSchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonExtractRules) ProtoMessage() {}
+func (*ExtractSchema) ProtoMessage() {}
 
-func (x *JsonExtractRules) ProtoReflect() protoreflect.Message {
-	mi := &file_sources_sources_proto_msgTypes[16]
+func (x *ExtractSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,62 +2569,53 @@ func (x *JsonExtractRules) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonExtractRules.ProtoReflect.Descriptor instead.
-func (*JsonExtractRules) Descriptor() ([]byte, []int) {
-	return file_sources_sources_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ExtractSchema.ProtoReflect.Descriptor instead.
+func (*ExtractSchema) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *JsonExtractRules) GetContainer() string {
+func (x *ExtractSchema) GetFields() []*SchemaField {
 	if x != nil {
-		return x.Container
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-func (x *JsonExtractRules) GetList() string {
+func (x *ExtractSchema) GetInvalid() string {
 	if x != nil {
-		return x.List
+		return x.Invalid
 	}
 	return ""
 }
 
-func (x *JsonExtractRules) GetFields() []*JsonExtractField {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-type JsonExtractField struct {
+type SchemaField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path         string              `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Match        string              `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
-	RegexReplace []*RegexReplace     `protobuf:"bytes,4,rep,name=regexReplace,proto3" json:"regexReplace,omitempty"`
-	TextReplace  []*TextReplace      `protobuf:"bytes,5,rep,name=textReplace,proto3" json:"textReplace,omitempty"`
-	Fields       []*JsonExtractField `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Required bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Default  string `protobuf:"bytes,4,opt,name=default,proto3" json:"default,omitempty"`
 }
 
-func (x *JsonExtractField) Reset() {
-	*x = JsonExtractField{}
+func (x *SchemaField) Reset() {
+	*x = SchemaField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sources_sources_proto_msgTypes[17]
+		mi := &file_sources_sources_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JsonExtractField) String() string {
+func (x *SchemaField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonExtractField) ProtoMessage() {}
+func (*SchemaField) ProtoMessage() {}
 
-func (x *JsonExtractField) ProtoReflect() protoreflect.Message {
-	mi := &file_sources_sources_proto_msgTypes[17]
+func (x *SchemaField) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,79 +2626,69 @@ func (x *JsonExtractField) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonExtractField.ProtoReflect.Descriptor instead.
-func (*JsonExtractField) Descriptor() ([]byte, []int) {
-	return file_sources_sources_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SchemaField.ProtoReflect.Descriptor instead.
+func (*SchemaField) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *JsonExtractField) GetName() string {
+func (x *SchemaField) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *JsonExtractField) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *JsonExtractField) GetMatch() string {
+func (x *SchemaField) GetType() string {
 	if x != nil {
-		return x.Match
+		return x.Type
 	}
 	return ""
 }
 
-func (x *JsonExtractField) GetRegexReplace() []*RegexReplace {
-	if x != nil {
-		return x.RegexReplace
-	}
-	return nil
-}
-
-func (x *JsonExtractField) GetTextReplace() []*TextReplace {
+func (x *SchemaField) GetRequired() bool {
 	if x != nil {
-		return x.TextReplace
+		return x.Required
 	}
-	return nil
+	return false
 }
 
-func (x *JsonExtractField) GetFields() []*JsonExtractField {
+func (x *SchemaField) GetDefault() string {
 	if x != nil {
-		return x.Fields
+		return x.Default
 	}
-	return nil
+	return ""
 }
 
-type RegexReplace struct {
+type Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Field string    `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Op    string    `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Value string    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	All   []*Filter `protobuf:"bytes,4,rep,name=all,proto3" json:"all,omitempty"`
+	Any   []*Filter `protobuf:"bytes,5,rep,name=any,proto3" json:"any,omitempty"`
+	Not   *Filter   `protobuf:"bytes,6,opt,name=not,proto3" json:"not,omitempty"`
 }
 
-func (x *RegexReplace) Reset() {
-	*x = RegexReplace{}
+func (x *Filter) Reset() {
+	*x = Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sources_sources_proto_msgTypes[18]
+		mi := &file_sources_sources_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegexReplace) String() string {
+func (x *Filter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegexReplace) ProtoMessage() {}
+func (*Filter) ProtoMessage() {}
 
-func (x *RegexReplace) ProtoReflect() protoreflect.Message {
-	mi := &file_sources_sources_proto_msgTypes[18]
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,51 +2699,79 @@ func (x *RegexReplace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegexReplace.ProtoReflect.Descriptor instead.
-func (*RegexReplace) Descriptor() ([]byte, []int) {
-	return file_sources_sources_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RegexReplace) GetPattern() string {
+func (x *Filter) GetField() string {
 	if x != nil {
-		return x.Pattern
+		return x.Field
 	}
 	return ""
 }
 
-func (x *RegexReplace) GetValue() string {
+func (x *Filter) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Filter) GetValue() string {
 	if x != nil {
 		return x.Value
 	}
 	return ""
 }
 
-type TextReplace struct {
+func (x *Filter) GetAll() []*Filter {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *Filter) GetAny() []*Filter {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *Filter) GetNot() *Filter {
+	if x != nil {
+		return x.Not
+	}
+	return nil
+}
+
+type ComputedField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text  string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
 }
 
-func (x *TextReplace) Reset() {
-	*x = TextReplace{}
+func (x *ComputedField) Reset() {
+	*x = ComputedField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sources_sources_proto_msgTypes[19]
+		mi := &file_sources_sources_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TextReplace) String() string {
+func (x *ComputedField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextReplace) ProtoMessage() {}
+func (*ComputedField) ProtoMessage() {}
 
-func (x *TextReplace) ProtoReflect() protoreflect.Message {
-	mi := &file_sources_sources_proto_msgTypes[19]
+func (x *ComputedField) ProtoReflect() protoreflect.Message {
+	mi := &file_sources_sources_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,21 +2782,21 @@ func (x *TextReplace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextReplace.ProtoReflect.Descriptor instead.
-func (*TextReplace) Descriptor() ([]byte, []int) {
-	return file_sources_sources_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ComputedField.ProtoReflect.Descriptor instead.
+func (*ComputedField) Descriptor() ([]byte, []int) {
+	return file_sources_sources_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *TextReplace) GetText() string {
+func (x *ComputedField) GetName() string {
 	if x != nil {
-		return x.Text
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TextReplace) GetValue() string {
+func (x *ComputedField) GetTemplate() string {
 	if x != nil {
-		return x.Value
+		return x.Template
 	}
 	return ""
 }
@@ -1416,7 +2832,7 @@ var file_sources_sources_proto_rawDesc = []byte{
 	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
 	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x04, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72,
+	0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x90, 0x05, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
 	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
@@ -1452,192 +2868,489 @@ var file_sources_sources_proto_rawDesc = []byte{
 	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
 	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x92, 0x03, 0x0a,
-	0x0b, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x4f, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5b,
-	0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0c, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x22, 0x36, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63,
-	0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x4a, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x22, 0x33, 0x0a, 0x05, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4f, 0x0a, 0x09, 0x48, 0x74, 0x74, 0x70, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x3f, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x04,
-	0x68, 0x74, 0x6d, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f,
-	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
-	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x12, 0x4f, 0x0a,
-	0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe0, 0x03, 0x0a, 0x0b, 0x53,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x4f, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0c,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x36, 0x0a,
+	0x0a, 0x48, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa8, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x46, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x6f,
+	0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c,
+	0x12, 0x4a, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61,
 	0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c,
 	0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x87,
-	0x01, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69,
-	0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73,
-	0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x48, 0x74, 0x6d,
-	0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x58, 0x0a,
-	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3a, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e,
-	0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d,
-	0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x4a, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x61, 0x6f, 0x6e,
+	0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e,
+	0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x52, 0x6f, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x52, 0x6f, 0x62, 0x6f, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x72, 0x73, 0x65,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74,
+	0x22, 0x59, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x05,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x4f, 0x0a, 0x09, 0x48, 0x74, 0x74, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x22, 0x3f, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x6f,
+	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
+	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x22, 0xa4, 0x05, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x12, 0x4f, 0x0a, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75,
+	0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x06, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x74, 0x61, 0x6f,
+	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
+	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x4c, 0x0a, 0x03, 0x78, 0x6d, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72,
+	0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x58, 0x6d, 0x6c, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x03, 0x78, 0x6d, 0x6c, 0x12,
+	0x50, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x12, 0x58, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x5b, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3f, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xb4, 0x03, 0x0a, 0x10, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74,
+	0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77,
+	0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74,
+	0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f, 0x6e,
+	0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e,
+	0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75,
 	0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70,
 	0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x57, 0x0a, 0x0f,
+	0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x0f,
 	0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
 	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61,
 	0x74, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12,
 	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x98, 0x03, 0x0a, 0x10, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e,
-	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74,
-	0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77,
-	0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x5b, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x73, 0x22, 0x9d, 0x05, 0x0a, 0x10, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x61, 0x6f,
 	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
 	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x12, 0x58, 0x0a, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0b,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x5b, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69,
+	0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73,
+	0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x58, 0x0a, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e,
+	0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e,
+	0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54,
+	0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69,
+	0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73,
+	0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0a,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0c, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e,
+	0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67,
+	0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xd8, 0x01, 0x0a, 0x13, 0x41, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x74, 0x61, 0x6f,
+	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
+	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x22, 0x30,
+	0x0a, 0x14, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x22, 0xd1, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x4f, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4a, 0x73,
+	0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x04,
+	0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x92, 0x03, 0x0a, 0x10, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61,
 	0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c,
 	0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72,
 	0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x22, 0x99, 0x01, 0x0a, 0x10, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75,
-	0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xdc, 0x02, 0x0a,
-	0x10, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x5b, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0c,
-	0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0b,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x12, 0x4b, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
 	0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x65,
-	0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x54,
-	0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x32, 0xfe, 0x02, 0x0a, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x12, 0x73, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x35, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75,
-	0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70,
-	0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74,
+	0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xad, 0x04, 0x0a, 0x10, 0x4a, 0x73,
+	0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x5b, 0x0a, 0x0c,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
 	0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72,
 	0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x79, 0x53,
-	0x6c, 0x75, 0x67, 0x12, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
+	0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74,
+	0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77,
+	0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x5b,
+	0x0a, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x0c, 0x72,
+	0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x0f, 0x58, 0x6d,
+	0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x57, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e,
+	0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e,
+	0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x58, 0x6d, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x52, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e,
+	0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x58, 0x6d, 0x6c,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x38, 0x0a, 0x0c, 0x58, 0x6d, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x8d, 0x04, 0x0a, 0x0f,
+	0x58, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x5b, 0x0a,
+	0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63,
 	0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72,
 	0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x39, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e,
+	0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x58, 0x6d, 0x6c, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74,
+	0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77,
+	0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x5b,
+	0x0a, 0x0c, 0x72, 0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x0c, 0x72,
+	0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x3e, 0x0a, 0x0c, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x64, 0x0a, 0x0c, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x69, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x73,
+	0x73, 0x22, 0x37, 0x0a, 0x0b, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x79, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x4e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22,
+	0x6b, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x93, 0x02, 0x0a,
+	0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e,
 	0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x76, 0x0a,
-	0x04, 0x53, 0x61, 0x76, 0x65, 0x12, 0x36, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x43, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c,
 	0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64,
 	0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x43, 0x0a,
+	0x03, 0x6e, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x61, 0x6f,
+	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
+	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x6e,
+	0x6f, 0x74, 0x22, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x32, 0xff, 0x03, 0x0a, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x73, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x35, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
 	0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61,
 	0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x79, 0x53, 0x6c,
+	0x75, 0x67, 0x12, 0x3b, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x04,
+	0x53, 0x61, 0x76, 0x65, 0x12, 0x36, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x74,
+	0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77,
+	0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x39, 0x2e, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63,
+	0x72, 0x61, 0x77, 0x6c, 0x73, 0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x74, 0x61, 0x6f,
+	0x6e, 0x69, 0x75, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73,
+	0x2e, 0x73, 0x70, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
 	0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x74, 0x61, 0x6f, 0x6e, 0x69, 0x75, 0x2e,
 	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x63, 0x72, 0x61, 0x77, 0x6c, 0x73, 0x2f, 0x73, 0x70, 0x69,
 	0x64, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
@@ -1656,68 +3369,118 @@ func file_sources_sources_proto_rawDescGZIP() []byte {
 	return file_sources_sources_proto_rawDescData
 }
 
-var file_sources_sources_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_sources_sources_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_sources_sources_proto_goTypes = []interface{}{
-	(*GetRequest)(nil),          // 0: taoniu.local.crawls.spiders.grpc.services.GetRequest
-	(*GetReply)(nil),            // 1: taoniu.local.crawls.spiders.grpc.services.GetReply
-	(*GetBySlugRequest)(nil),    // 2: taoniu.local.crawls.spiders.grpc.services.GetBySlugRequest
-	(*GetBySlugReply)(nil),      // 3: taoniu.local.crawls.spiders.grpc.services.GetBySlugReply
-	(*SourceInfo)(nil),          // 4: taoniu.local.crawls.spiders.grpc.services.SourceInfo
-	(*SaveRequest)(nil),         // 5: taoniu.local.crawls.spiders.grpc.services.SaveRequest
-	(*HttpHeader)(nil),          // 6: taoniu.local.crawls.spiders.grpc.services.HttpHeader
-	(*Params)(nil),              // 7: taoniu.local.crawls.spiders.grpc.services.Params
-	(*Split)(nil),               // 8: taoniu.local.crawls.spiders.grpc.services.Split
-	(*HttpQuery)(nil),           // 9: taoniu.local.crawls.spiders.grpc.services.HttpQuery
-	(*SaveReply)(nil),           // 10: taoniu.local.crawls.spiders.grpc.services.SaveReply
-	(*ExtractRules)(nil),        // 11: taoniu.local.crawls.spiders.grpc.services.ExtractRules
-	(*ExtractResult)(nil),       // 12: taoniu.local.crawls.spiders.grpc.services.ExtractResult
-	(*HtmlExtractRules)(nil),    // 13: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules
-	(*HtmlExtractNode)(nil),     // 14: taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
-	(*HtmlExtractField)(nil),    // 15: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField
-	(*JsonExtractRules)(nil),    // 16: taoniu.local.crawls.spiders.grpc.services.JsonExtractRules
-	(*JsonExtractField)(nil),    // 17: taoniu.local.crawls.spiders.grpc.services.JsonExtractField
-	(*RegexReplace)(nil),        // 18: taoniu.local.crawls.spiders.grpc.services.RegexReplace
-	(*TextReplace)(nil),         // 19: taoniu.local.crawls.spiders.grpc.services.TextReplace
-	(*timestamp.Timestamp)(nil), // 20: google.protobuf.Timestamp
+	(*GetRequest)(nil),           // 0: taoniu.local.crawls.spiders.grpc.services.GetRequest
+	(*GetReply)(nil),             // 1: taoniu.local.crawls.spiders.grpc.services.GetReply
+	(*GetBySlugRequest)(nil),     // 2: taoniu.local.crawls.spiders.grpc.services.GetBySlugRequest
+	(*GetBySlugReply)(nil),       // 3: taoniu.local.crawls.spiders.grpc.services.GetBySlugReply
+	(*SourceInfo)(nil),           // 4: taoniu.local.crawls.spiders.grpc.services.SourceInfo
+	(*SaveRequest)(nil),          // 5: taoniu.local.crawls.spiders.grpc.services.SaveRequest
+	(*HttpHeader)(nil),           // 6: taoniu.local.crawls.spiders.grpc.services.HttpHeader
+	(*Params)(nil),               // 7: taoniu.local.crawls.spiders.grpc.services.Params
+	(*RateLimit)(nil),            // 8: taoniu.local.crawls.spiders.grpc.services.RateLimit
+	(*ProxyParams)(nil),          // 9: taoniu.local.crawls.spiders.grpc.services.ProxyParams
+	(*Split)(nil),                // 10: taoniu.local.crawls.spiders.grpc.services.Split
+	(*HttpQuery)(nil),            // 11: taoniu.local.crawls.spiders.grpc.services.HttpQuery
+	(*SaveReply)(nil),            // 12: taoniu.local.crawls.spiders.grpc.services.SaveReply
+	(*PreviewRequest)(nil),       // 13: taoniu.local.crawls.spiders.grpc.services.PreviewRequest
+	(*PreviewReply)(nil),         // 14: taoniu.local.crawls.spiders.grpc.services.PreviewReply
+	(*ExtractRules)(nil),         // 15: taoniu.local.crawls.spiders.grpc.services.ExtractRules
+	(*ExtractResult)(nil),        // 16: taoniu.local.crawls.spiders.grpc.services.ExtractResult
+	(*HtmlExtractRules)(nil),     // 17: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules
+	(*HtmlExtractNode)(nil),      // 18: taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
+	(*HtmlExtractField)(nil),     // 19: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField
+	(*ArticleExtractRules)(nil),  // 20: taoniu.local.crawls.spiders.grpc.services.ArticleExtractRules
+	(*MetadataExtractRules)(nil), // 21: taoniu.local.crawls.spiders.grpc.services.MetadataExtractRules
+	(*ScriptExtractRules)(nil),   // 22: taoniu.local.crawls.spiders.grpc.services.ScriptExtractRules
+	(*JsonExtractRules)(nil),     // 23: taoniu.local.crawls.spiders.grpc.services.JsonExtractRules
+	(*JsonExtractField)(nil),     // 24: taoniu.local.crawls.spiders.grpc.services.JsonExtractField
+	(*XmlExtractRules)(nil),      // 25: taoniu.local.crawls.spiders.grpc.services.XmlExtractRules
+	(*XmlNamespace)(nil),         // 26: taoniu.local.crawls.spiders.grpc.services.XmlNamespace
+	(*XmlExtractField)(nil),      // 27: taoniu.local.crawls.spiders.grpc.services.XmlExtractField
+	(*RegexReplace)(nil),         // 28: taoniu.local.crawls.spiders.grpc.services.RegexReplace
+	(*RegexExtract)(nil),         // 29: taoniu.local.crawls.spiders.grpc.services.RegexExtract
+	(*TextReplace)(nil),          // 30: taoniu.local.crawls.spiders.grpc.services.TextReplace
+	(*Transform)(nil),            // 31: taoniu.local.crawls.spiders.grpc.services.Transform
+	(*ExtractSchema)(nil),        // 32: taoniu.local.crawls.spiders.grpc.services.ExtractSchema
+	(*SchemaField)(nil),          // 33: taoniu.local.crawls.spiders.grpc.services.SchemaField
+	(*Filter)(nil),               // 34: taoniu.local.crawls.spiders.grpc.services.Filter
+	(*ComputedField)(nil),        // 35: taoniu.local.crawls.spiders.grpc.services.ComputedField
+	(*timestamp.Timestamp)(nil),  // 36: google.protobuf.Timestamp
 }
 var file_sources_sources_proto_depIdxs = []int32{
 	4,  // 0: taoniu.local.crawls.spiders.grpc.services.GetReply.data:type_name -> taoniu.local.crawls.spiders.grpc.services.SourceInfo
 	4,  // 1: taoniu.local.crawls.spiders.grpc.services.GetBySlugReply.data:type_name -> taoniu.local.crawls.spiders.grpc.services.SourceInfo
 	6,  // 2: taoniu.local.crawls.spiders.grpc.services.SourceInfo.headers:type_name -> taoniu.local.crawls.spiders.grpc.services.HttpHeader
-	11, // 3: taoniu.local.crawls.spiders.grpc.services.SourceInfo.extractRules:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractRules
-	12, // 4: taoniu.local.crawls.spiders.grpc.services.SourceInfo.extractResult:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractResult
-	20, // 5: taoniu.local.crawls.spiders.grpc.services.SourceInfo.createdAt:type_name -> google.protobuf.Timestamp
-	20, // 6: taoniu.local.crawls.spiders.grpc.services.SourceInfo.updatedAt:type_name -> google.protobuf.Timestamp
+	15, // 3: taoniu.local.crawls.spiders.grpc.services.SourceInfo.extractRules:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractRules
+	16, // 4: taoniu.local.crawls.spiders.grpc.services.SourceInfo.extractResult:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractResult
+	36, // 5: taoniu.local.crawls.spiders.grpc.services.SourceInfo.createdAt:type_name -> google.protobuf.Timestamp
+	36, // 6: taoniu.local.crawls.spiders.grpc.services.SourceInfo.updatedAt:type_name -> google.protobuf.Timestamp
 	6,  // 7: taoniu.local.crawls.spiders.grpc.services.SaveRequest.headers:type_name -> taoniu.local.crawls.spiders.grpc.services.HttpHeader
 	7,  // 8: taoniu.local.crawls.spiders.grpc.services.SaveRequest.params:type_name -> taoniu.local.crawls.spiders.grpc.services.Params
-	11, // 9: taoniu.local.crawls.spiders.grpc.services.SaveRequest.extractRules:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractRules
-	8,  // 10: taoniu.local.crawls.spiders.grpc.services.Params.split:type_name -> taoniu.local.crawls.spiders.grpc.services.Split
-	9,  // 11: taoniu.local.crawls.spiders.grpc.services.Params.query:type_name -> taoniu.local.crawls.spiders.grpc.services.HttpQuery
-	13, // 12: taoniu.local.crawls.spiders.grpc.services.ExtractRules.html:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules
-	16, // 13: taoniu.local.crawls.spiders.grpc.services.ExtractRules.json:type_name -> taoniu.local.crawls.spiders.grpc.services.JsonExtractRules
-	12, // 14: taoniu.local.crawls.spiders.grpc.services.ExtractResult.data:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractResult
-	14, // 15: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.container:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
-	14, // 16: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.list:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
-	15, // 17: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractField
-	14, // 18: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.node:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
-	18, // 19: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.regexReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexReplace
-	19, // 20: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.textReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.TextReplace
-	15, // 21: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractField
-	17, // 22: taoniu.local.crawls.spiders.grpc.services.JsonExtractRules.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.JsonExtractField
-	18, // 23: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.regexReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexReplace
-	19, // 24: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.textReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.TextReplace
-	17, // 25: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.JsonExtractField
-	0,  // 26: taoniu.local.crawls.spiders.grpc.services.Sources.Get:input_type -> taoniu.local.crawls.spiders.grpc.services.GetRequest
-	2,  // 27: taoniu.local.crawls.spiders.grpc.services.Sources.GetBySlug:input_type -> taoniu.local.crawls.spiders.grpc.services.GetBySlugRequest
-	5,  // 28: taoniu.local.crawls.spiders.grpc.services.Sources.Save:input_type -> taoniu.local.crawls.spiders.grpc.services.SaveRequest
-	1,  // 29: taoniu.local.crawls.spiders.grpc.services.Sources.Get:output_type -> taoniu.local.crawls.spiders.grpc.services.GetReply
-	3,  // 30: taoniu.local.crawls.spiders.grpc.services.Sources.GetBySlug:output_type -> taoniu.local.crawls.spiders.grpc.services.GetBySlugReply
-	10, // 31: taoniu.local.crawls.spiders.grpc.services.Sources.Save:output_type -> taoniu.local.crawls.spiders.grpc.services.SaveReply
-	29, // [29:32] is the sub-list for method output_type
-	26, // [26:29] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	15, // 9: taoniu.local.crawls.spiders.grpc.services.SaveRequest.extractRules:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractRules
+	10, // 10: taoniu.local.crawls.spiders.grpc.services.Params.split:type_name -> taoniu.local.crawls.spiders.grpc.services.Split
+	11, // 11: taoniu.local.crawls.spiders.grpc.services.Params.query:type_name -> taoniu.local.crawls.spiders.grpc.services.HttpQuery
+	9,  // 12: taoniu.local.crawls.spiders.grpc.services.Params.proxy:type_name -> taoniu.local.crawls.spiders.grpc.services.ProxyParams
+	8,  // 13: taoniu.local.crawls.spiders.grpc.services.Params.limit:type_name -> taoniu.local.crawls.spiders.grpc.services.RateLimit
+	5,  // 14: taoniu.local.crawls.spiders.grpc.services.PreviewRequest.source:type_name -> taoniu.local.crawls.spiders.grpc.services.SaveRequest
+	17, // 15: taoniu.local.crawls.spiders.grpc.services.ExtractRules.html:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules
+	23, // 16: taoniu.local.crawls.spiders.grpc.services.ExtractRules.json:type_name -> taoniu.local.crawls.spiders.grpc.services.JsonExtractRules
+	22, // 17: taoniu.local.crawls.spiders.grpc.services.ExtractRules.script:type_name -> taoniu.local.crawls.spiders.grpc.services.ScriptExtractRules
+	25, // 18: taoniu.local.crawls.spiders.grpc.services.ExtractRules.xml:type_name -> taoniu.local.crawls.spiders.grpc.services.XmlExtractRules
+	32, // 19: taoniu.local.crawls.spiders.grpc.services.ExtractRules.schema:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractSchema
+	20, // 20: taoniu.local.crawls.spiders.grpc.services.ExtractRules.article:type_name -> taoniu.local.crawls.spiders.grpc.services.ArticleExtractRules
+	21, // 21: taoniu.local.crawls.spiders.grpc.services.ExtractRules.metadata:type_name -> taoniu.local.crawls.spiders.grpc.services.MetadataExtractRules
+	16, // 22: taoniu.local.crawls.spiders.grpc.services.ExtractResult.data:type_name -> taoniu.local.crawls.spiders.grpc.services.ExtractResult
+	18, // 23: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.container:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
+	18, // 24: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.list:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
+	19, // 25: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractField
+	34, // 26: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.filters:type_name -> taoniu.local.crawls.spiders.grpc.services.Filter
+	35, // 27: taoniu.local.crawls.spiders.grpc.services.HtmlExtractRules.computed:type_name -> taoniu.local.crawls.spiders.grpc.services.ComputedField
+	18, // 28: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.node:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
+	28, // 29: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.regexReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexReplace
+	30, // 30: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.textReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.TextReplace
+	19, // 31: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractField
+	31, // 32: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.transforms:type_name -> taoniu.local.crawls.spiders.grpc.services.Transform
+	29, // 33: taoniu.local.crawls.spiders.grpc.services.HtmlExtractField.regexExtract:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexExtract
+	19, // 34: taoniu.local.crawls.spiders.grpc.services.ArticleExtractRules.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractField
+	35, // 35: taoniu.local.crawls.spiders.grpc.services.ArticleExtractRules.computed:type_name -> taoniu.local.crawls.spiders.grpc.services.ComputedField
+	18, // 36: taoniu.local.crawls.spiders.grpc.services.ScriptExtractRules.node:type_name -> taoniu.local.crawls.spiders.grpc.services.HtmlExtractNode
+	23, // 37: taoniu.local.crawls.spiders.grpc.services.ScriptExtractRules.json:type_name -> taoniu.local.crawls.spiders.grpc.services.JsonExtractRules
+	24, // 38: taoniu.local.crawls.spiders.grpc.services.JsonExtractRules.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.JsonExtractField
+	34, // 39: taoniu.local.crawls.spiders.grpc.services.JsonExtractRules.filters:type_name -> taoniu.local.crawls.spiders.grpc.services.Filter
+	35, // 40: taoniu.local.crawls.spiders.grpc.services.JsonExtractRules.computed:type_name -> taoniu.local.crawls.spiders.grpc.services.ComputedField
+	28, // 41: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.regexReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexReplace
+	30, // 42: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.textReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.TextReplace
+	24, // 43: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.JsonExtractField
+	31, // 44: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.transforms:type_name -> taoniu.local.crawls.spiders.grpc.services.Transform
+	29, // 45: taoniu.local.crawls.spiders.grpc.services.JsonExtractField.regexExtract:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexExtract
+	26, // 46: taoniu.local.crawls.spiders.grpc.services.XmlExtractRules.namespaces:type_name -> taoniu.local.crawls.spiders.grpc.services.XmlNamespace
+	27, // 47: taoniu.local.crawls.spiders.grpc.services.XmlExtractRules.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.XmlExtractField
+	34, // 48: taoniu.local.crawls.spiders.grpc.services.XmlExtractRules.filters:type_name -> taoniu.local.crawls.spiders.grpc.services.Filter
+	28, // 49: taoniu.local.crawls.spiders.grpc.services.XmlExtractField.regexReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexReplace
+	30, // 50: taoniu.local.crawls.spiders.grpc.services.XmlExtractField.textReplace:type_name -> taoniu.local.crawls.spiders.grpc.services.TextReplace
+	27, // 51: taoniu.local.crawls.spiders.grpc.services.XmlExtractField.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.XmlExtractField
+	31, // 52: taoniu.local.crawls.spiders.grpc.services.XmlExtractField.transforms:type_name -> taoniu.local.crawls.spiders.grpc.services.Transform
+	29, // 53: taoniu.local.crawls.spiders.grpc.services.XmlExtractField.regexExtract:type_name -> taoniu.local.crawls.spiders.grpc.services.RegexExtract
+	33, // 54: taoniu.local.crawls.spiders.grpc.services.ExtractSchema.fields:type_name -> taoniu.local.crawls.spiders.grpc.services.SchemaField
+	34, // 55: taoniu.local.crawls.spiders.grpc.services.Filter.all:type_name -> taoniu.local.crawls.spiders.grpc.services.Filter
+	34, // 56: taoniu.local.crawls.spiders.grpc.services.Filter.any:type_name -> taoniu.local.crawls.spiders.grpc.services.Filter
+	34, // 57: taoniu.local.crawls.spiders.grpc.services.Filter.not:type_name -> taoniu.local.crawls.spiders.grpc.services.Filter
+	0,  // 58: taoniu.local.crawls.spiders.grpc.services.Sources.Get:input_type -> taoniu.local.crawls.spiders.grpc.services.GetRequest
+	2,  // 59: taoniu.local.crawls.spiders.grpc.services.Sources.GetBySlug:input_type -> taoniu.local.crawls.spiders.grpc.services.GetBySlugRequest
+	5,  // 60: taoniu.local.crawls.spiders.grpc.services.Sources.Save:input_type -> taoniu.local.crawls.spiders.grpc.services.SaveRequest
+	13, // 61: taoniu.local.crawls.spiders.grpc.services.Sources.Preview:input_type -> taoniu.local.crawls.spiders.grpc.services.PreviewRequest
+	1,  // 62: taoniu.local.crawls.spiders.grpc.services.Sources.Get:output_type -> taoniu.local.crawls.spiders.grpc.services.GetReply
+	3,  // 63: taoniu.local.crawls.spiders.grpc.services.Sources.GetBySlug:output_type -> taoniu.local.crawls.spiders.grpc.services.GetBySlugReply
+	12, // 64: taoniu.local.crawls.spiders.grpc.services.Sources.Save:output_type -> taoniu.local.crawls.spiders.grpc.services.SaveReply
+	14, // 65: taoniu.local.crawls.spiders.grpc.services.Sources.Preview:output_type -> taoniu.local.crawls.spiders.grpc.services.PreviewReply
+	62, // [62:66] is the sub-list for method output_type
+	58, // [58:62] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_sources_sources_proto_init() }
@@ -1823,7 +3586,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Split); i {
+			switch v := v.(*RateLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1835,7 +3598,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpQuery); i {
+			switch v := v.(*ProxyParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1847,7 +3610,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveReply); i {
+			switch v := v.(*Split); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1859,7 +3622,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractRules); i {
+			switch v := v.(*HttpQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1871,7 +3634,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractResult); i {
+			switch v := v.(*SaveReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1883,7 +3646,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HtmlExtractRules); i {
+			switch v := v.(*PreviewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1895,7 +3658,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HtmlExtractNode); i {
+			switch v := v.(*PreviewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1907,7 +3670,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HtmlExtractField); i {
+			switch v := v.(*ExtractRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1919,7 +3682,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JsonExtractRules); i {
+			switch v := v.(*ExtractResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1931,7 +3694,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JsonExtractField); i {
+			switch v := v.(*HtmlExtractRules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1943,7 +3706,7 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegexReplace); i {
+			switch v := v.(*HtmlExtractNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1955,6 +3718,138 @@ func file_sources_sources_proto_init() {
 			}
 		}
 		file_sources_sources_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HtmlExtractField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArticleExtractRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetadataExtractRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptExtractRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JsonExtractRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JsonExtractField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XmlExtractRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XmlNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XmlExtractField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegexReplace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegexExtract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TextReplace); i {
 			case 0:
 				return &v.state
@@ -1966,6 +3861,66 @@ func file_sources_sources_proto_init() {
 				return nil
 			}
 		}
+		file_sources_sources_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtractSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sources_sources_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComputedField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1973,7 +3928,7 @@ func file_sources_sources_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sources_sources_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,7 @@ type SourcesClient interface {
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error)
 	GetBySlug(ctx context.Context, in *GetBySlugRequest, opts ...grpc.CallOption) (*GetBySlugReply, error)
 	Save(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveReply, error)
+	Preview(ctx context.Context, in *PreviewRequest, opts ...grpc.CallOption) (*PreviewReply, error)
 }
 
 type sourcesClient struct {
@@ -62,6 +63,15 @@ func (c *sourcesClient) Save(ctx context.Context, in *SaveRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *sourcesClient) Preview(ctx context.Context, in *PreviewRequest, opts ...grpc.CallOption) (*PreviewReply, error) {
+	out := new(PreviewReply)
+	err := c.cc.Invoke(ctx, "/taoniu.local.crawls.spiders.grpc.services.Sources/Preview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SourcesServer is the server API for Sources service.
 // All implementations must embed UnimplementedSourcesServer
 // for forward compatibility
@@ -69,6 +79,7 @@ type SourcesServer interface {
 	Get(context.Context, *GetRequest) (*GetReply, error)
 	GetBySlug(context.Context, *GetBySlugRequest) (*GetBySlugReply, error)
 	Save(context.Context, *SaveRequest) (*SaveReply, error)
+	Preview(context.Context, *PreviewRequest) (*PreviewReply, error)
 	mustEmbedUnimplementedSourcesServer()
 }
 
@@ -85,6 +96,9 @@ func (UnimplementedSourcesServer) GetBySlug(context.Context, *GetBySlugRequest)
 func (UnimplementedSourcesServer) Save(context.Context, *SaveRequest) (*SaveReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
 }
+func (UnimplementedSourcesServer) Preview(context.Context, *PreviewRequest) (*PreviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Preview not implemented")
+}
 func (UnimplementedSourcesServer) mustEmbedUnimplementedSourcesServer() {}
 
 // UnsafeSourcesServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +166,24 @@ func _Sources_Save_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sources_Preview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SourcesServer).Preview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taoniu.local.crawls.spiders.grpc.services.Sources/Preview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SourcesServer).Preview(ctx, req.(*PreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sources_ServiceDesc is the grpc.ServiceDesc for Sources service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +203,10 @@ var Sources_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Save",
 			Handler:    _Sources_Save_Handler,
 		},
+		{
+			MethodName: "Preview",
+			Handler:    _Sources_Preview_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sources/sources.proto",
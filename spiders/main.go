@@ -28,7 +28,7 @@ func main() {
       if c.Command.Action == nil {
         cli.ShowAppHelp(c)
       } else {
-        log.Fatalln("error", c.Err)
+        log.Fatalln("error", c.Err())
       }
       return nil
     },
@@ -38,6 +38,7 @@ func main() {
       commands.NewDbCommand(),
       commands.NewQueueCommand(),
       commands.NewSourcesCommand(),
+      commands.NewProxiesCommand(),
       commands.NewTasksCommand(),
       commands.NewGrpcCommand(),
     },
@@ -0,0 +1,28 @@
+package models
+
+import (
+  "gorm.io/datatypes"
+  "time"
+)
+
+type Proxy struct {
+  ID           string         `gorm:"size:20;primaryKey"`
+  Pool         string         `gorm:"size:50;not null;index"`
+  Protocol     string         `gorm:"size:10;not null"`
+  Host         string         `gorm:"size:155;not null;uniqueIndex:idx_spiders_proxies_address"`
+  Port         int            `gorm:"not null;uniqueIndex:idx_spiders_proxies_address"`
+  Username     string         `gorm:"size:100;not null"`
+  Password     string         `gorm:"size:100;not null"`
+  Tags         datatypes.JSON `gorm:"not null"`
+  Score        float64        `gorm:"not null"`
+  Latency      int            `gorm:"not null"`
+  Failures     int            `gorm:"not null"`
+  BenchedUntil time.Time      `gorm:"not null;index"`
+  Status       int            `gorm:"not null;index"`
+  CreatedAt    time.Time      `gorm:"not null"`
+  UpdatedAt    time.Time      `gorm:"not null"`
+}
+
+func (m *Proxy) TableName() string {
+  return "spiders_proxies"
+}
@@ -13,6 +13,9 @@ type Source struct {
   Url          string            `gorm:"size:155;not null;"`
   Headers      datatypes.JSONMap `gorm:"not null"`
   Params       datatypes.JSONMap `gorm:"not null"`
+  Method       string            `gorm:"size:10;not null;default:''"`
+  Body         string            `gorm:"size:5000;not null;default:''"`
+  ContentType  string            `gorm:"size:100;not null;default:''"`
   UseProxy     bool              `gorm:"not null"`
   Timeout      int               `gorm:"not null"`
   ExtractRules datatypes.JSONMap `gorm:"not null"`
@@ -6,15 +6,25 @@ import (
 )
 
 type Task struct {
-  ID            string            `gorm:"size:20;primaryKey"`
-  ParentID      string            `gorm:"size:20;not null;index"`
-  SourceID      string            `gorm:"size:20;not null;index"`
-  Url           string            `gorm:"size:155;not null;"`
-  UrlSha1       string            `gorm:"size:40;not null;index"`
-  ExtractResult datatypes.JSONMap `gorm:"not null"`
-  Status        int               `gorm:"not null;index"`
-  CreatedAt     time.Time         `gorm:"not null"`
-  UpdatedAt     time.Time         `gorm:"not null;index"`
+  ID             string            `gorm:"size:20;primaryKey"`
+  ParentID       string            `gorm:"size:20;not null;index"`
+  SourceID       string            `gorm:"size:20;not null;index"`
+  Url            string            `gorm:"size:155;not null;"`
+  UrlSha1        string            `gorm:"size:40;not null;index"`
+  ExtractResult  datatypes.JSONMap `gorm:"not null"`
+  RejectedCount  int               `gorm:"not null;default:0"`
+  RejectedResult datatypes.JSONMap `gorm:"not null;default:'{}'"`
+  MatchResult    datatypes.JSONMap `gorm:"not null;default:'{}'"`
+  ETag           string            `gorm:"column:etag;size:255;not null;default:''"`
+  LastModified   string            `gorm:"size:50;not null;default:''"`
+  ContentHash    string            `gorm:"size:40;not null;default:''"`
+  RulesVersion   string            `gorm:"size:40;not null;default:''"`
+  Attempts       int               `gorm:"not null;default:0"`
+  LastError      string            `gorm:"size:5000;not null;default:''"`
+  NextAttemptAt  time.Time         `gorm:"not null;default:'0001-01-01 00:00:00+00:00';index"`
+  Status         int               `gorm:"not null;index"`
+  CreatedAt      time.Time         `gorm:"not null"`
+  UpdatedAt      time.Time         `gorm:"not null;index"`
 }
 
 func (m *Task) TableName() string {
@@ -4,6 +4,7 @@ import (
   "context"
   "encoding/json"
   "fmt"
+  "log"
   "time"
 
   "github.com/go-redis/redis/v8"
@@ -35,6 +36,7 @@ func NewTasks() *Tasks {
   }
   h.Repository = &repositories.TasksRepository{
     Db:    h.Db,
+    Rdb:   h.Rdb,
     Nats:  h.Nats,
     Asynq: h.Asynq,
     Job:   &jobs.Tasks{},
@@ -61,8 +63,17 @@ func (h *Tasks) Process(ctx context.Context, t *asynq.Task) error {
   defer mutex.Unlock()
 
   task, err := h.Repository.Get(payload.ID)
-  if err == nil {
-    h.Repository.Process(task)
+  if err != nil {
+    return nil
+  }
+
+  err = h.Repository.Process(task)
+  if err != nil {
+    log.Println("tasks process error", task.ID, err)
+    if task.Status == 5 {
+      return nil
+    }
+    return err
   }
 
   return nil
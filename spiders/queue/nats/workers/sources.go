@@ -3,18 +3,15 @@ package workers
 import (
   "context"
   "encoding/json"
-  "fmt"
   "github.com/hibiken/asynq"
-  "net/url"
-  "strings"
 
   "github.com/go-redis/redis/v8"
   "github.com/nats-io/nats.go"
-  "github.com/tidwall/gjson"
   "gorm.io/datatypes"
   "gorm.io/gorm"
 
   "taoniu.local/crawls/spiders/common"
+  "taoniu.local/crawls/spiders/models"
   "taoniu.local/crawls/spiders/repositories"
 )
 
@@ -77,41 +74,19 @@ func (h *Sources) Split(
     if err != nil {
       return
     }
+    source := &models.Source{
+      ID:     sourceId,
+      Url:    sourceUrl,
+      Params: sourceParams,
+    }
+    page := h.Repository.Page(task.Url, nil)
     for _, split := range path {
-      gjson.GetBytes(content, split.(string)).ForEach(func(_, s gjson.Result) bool {
-        if strings.Contains(sourceUrl, "{}") {
-          sourceUrl = strings.Replace(sourceUrl, "{}", fmt.Sprintf("%v", s.Value()), 1)
-        }
-
-        url, err := url.Parse(sourceUrl)
+      for _, url := range h.Repository.Split(source, content, split.(string), page) {
+        err = h.Repository.Tasks().Save("", sourceId, url)
         if err != nil {
-          return false
-        }
-
-        values := url.Query()
-        if items, ok := sourceParams["query"].([]interface{}); ok {
-          for _, item := range items {
-            item := item.(map[string]interface{})
-            name := item["name"].(string)
-            value := item["value"].(string)
-            if value == "$0" {
-              value = s.Value().(string)
-            }
-            if value == "$1" {
-              continue
-            }
-            values[name] = []string{value}
-          }
+          return
         }
-        url.RawQuery = values.Encode()
-
-        err = h.Repository.Tasks().Save("", sourceId, url.String())
-        if err != nil {
-          return false
-        }
-
-        return true
-      })
+      }
     }
   })
   return nil
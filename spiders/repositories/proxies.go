@@ -0,0 +1,309 @@
+package repositories
+
+import (
+  "encoding/json"
+  "errors"
+  "fmt"
+  "hash/fnv"
+  "math/rand"
+  "net/url"
+  "os"
+  "sort"
+  "strconv"
+  "sync"
+  "sync/atomic"
+  "time"
+
+  "github.com/rs/xid"
+  "gorm.io/datatypes"
+  "gorm.io/gorm"
+
+  "taoniu.local/crawls/spiders/models"
+)
+
+const (
+  ProxyScoreInitial = 50
+  ProxyScoreMin     = 20
+  ProxyFailuresMax  = 3
+  ProxyCooldown     = 5 * time.Minute
+  ProxyLatencyMax   = 10000
+  ProxyStickyTTL    = 30 * time.Minute
+)
+
+var (
+  proxyCursors     sync.Map
+  proxySticky      sync.Map
+  proxyStickySweep int64
+)
+
+type ProxySticky struct {
+  ID        string
+  ExpiredAt time.Time
+}
+
+type ProxiesRepository struct {
+  Db *gorm.DB
+}
+
+type ProxyParams struct {
+  Pool     string `json:"pool"`
+  Tag      string `json:"tag"`
+  Rotation string `json:"rotation"`
+}
+
+func (r *ProxiesRepository) Find(id string) (*models.Proxy, error) {
+  var entity *models.Proxy
+  result := r.Db.First(&entity, "id", id)
+  if result.Error != nil {
+    return nil, result.Error
+  }
+  return entity, nil
+}
+
+func (r *ProxiesRepository) All(pool string) []*models.Proxy {
+  var proxies []*models.Proxy
+  query := r.Db.Order("pool asc, score desc")
+  if pool != "" {
+    query = query.Where("pool", pool)
+  }
+  query.Find(&proxies)
+  return proxies
+}
+
+func (r *ProxiesRepository) Save(
+  pool string,
+  rawUrl string,
+  tags []string,
+) error {
+  proxy, err := r.Parse(rawUrl)
+  if err != nil {
+    return err
+  }
+  if tags == nil {
+    tags = []string{}
+  }
+  buf, _ := json.Marshal(tags)
+
+  var entity *models.Proxy
+  result := r.Db.Where("host = ? AND port = ?", proxy.Host, proxy.Port).Take(&entity)
+  if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+    entity = proxy
+    entity.ID = xid.New().String()
+    entity.Pool = pool
+    entity.Tags = datatypes.JSON(buf)
+    entity.Score = ProxyScoreInitial
+    entity.Status = 1
+    r.Db.Create(&entity)
+  } else {
+    entity.Pool = pool
+    entity.Protocol = proxy.Protocol
+    entity.Username = proxy.Username
+    entity.Password = proxy.Password
+    entity.Tags = datatypes.JSON(buf)
+    entity.Status = 1
+    r.Db.Model(&models.Proxy{ID: entity.ID}).Updates(entity)
+  }
+
+  return nil
+}
+
+func (r *ProxiesRepository) Parse(rawUrl string) (*models.Proxy, error) {
+  address, err := url.Parse(rawUrl)
+  if err != nil {
+    return nil, err
+  }
+  switch address.Scheme {
+  case "socks5", "http", "https":
+  default:
+    return nil, errors.New(fmt.Sprintf("protocol %s not supported", address.Scheme))
+  }
+  port, err := strconv.Atoi(address.Port())
+  if err != nil {
+    return nil, errors.New(fmt.Sprintf("port %s invalid", address.Port()))
+  }
+  password, _ := address.User.Password()
+  return &models.Proxy{
+    Protocol: address.Scheme,
+    Host:     address.Hostname(),
+    Port:     port,
+    Username: address.User.Username(),
+    Password: password,
+  }, nil
+}
+
+func (r *ProxiesRepository) Delete(id string) error {
+  return r.Db.Delete(&models.Proxy{}, "id", id).Error
+}
+
+func (r *ProxiesRepository) Params(source *models.Source) *ProxyParams {
+  var params *ProxyParams
+  if value, ok := source.Params["proxy"]; ok {
+    buf, _ := json.Marshal(value)
+    json.Unmarshal(buf, &params)
+  }
+  return params
+}
+
+func (r *ProxiesRepository) Pick(source *models.Source, rawUrl string) (*models.Proxy, error) {
+  params := r.Params(source)
+  if params == nil {
+    if !source.UseProxy {
+      return nil, nil
+    }
+    return r.Default()
+  }
+
+  proxies := r.Available(params)
+  if len(proxies) == 0 {
+    return nil, errors.New(fmt.Sprintf("proxy pool[%s] tag[%s] not available", params.Pool, params.Tag))
+  }
+
+  key := fmt.Sprintf("%s:%s", params.Pool, params.Tag)
+  switch params.Rotation {
+  case "random":
+    return r.Weighted(proxies), nil
+  case "sticky":
+    host := rawUrl
+    if address, err := url.Parse(rawUrl); err == nil {
+      host = address.Hostname()
+    }
+    key = fmt.Sprintf("%s:%s", key, host)
+    r.Sweep()
+    if value, ok := proxySticky.Load(key); ok {
+      sticky := value.(*ProxySticky)
+      if sticky.ExpiredAt.After(time.Now()) {
+        for _, proxy := range proxies {
+          if proxy.ID == sticky.ID {
+            return proxy, nil
+          }
+        }
+      }
+    }
+    hash := fnv.New32a()
+    hash.Write([]byte(host))
+    proxy := proxies[hash.Sum32()%uint32(len(proxies))]
+    proxySticky.Store(key, &ProxySticky{
+      ID:        proxy.ID,
+      ExpiredAt: time.Now().Add(ProxyStickyTTL),
+    })
+    return proxy, nil
+  }
+
+  cursor, _ := proxyCursors.LoadOrStore(key, new(uint64))
+  index := atomic.AddUint64(cursor.(*uint64), 1) - 1
+  return proxies[index%uint64(len(proxies))], nil
+}
+
+func (r *ProxiesRepository) Default() (*models.Proxy, error) {
+  rawUrl := os.Getenv("SPIDERS_PROXY_DEFAULT")
+  if rawUrl == "" {
+    return nil, errors.New("default proxy not configured")
+  }
+  return r.Parse(rawUrl)
+}
+
+func (r *ProxiesRepository) Sweep() {
+  now := time.Now()
+  last := atomic.LoadInt64(&proxyStickySweep)
+  if now.UnixNano()-last < int64(ProxyStickyTTL) || !atomic.CompareAndSwapInt64(&proxyStickySweep, last, now.UnixNano()) {
+    return
+  }
+  proxySticky.Range(func(key, value interface{}) bool {
+    if !value.(*ProxySticky).ExpiredAt.After(now) {
+      proxySticky.Delete(key)
+    }
+    return true
+  })
+}
+
+func (r *ProxiesRepository) Available(params *ProxyParams) []*models.Proxy {
+  var proxies []*models.Proxy
+  query := r.Db.Where("status = 1 AND benched_until < ?", time.Now())
+  if params.Pool != "" {
+    query = query.Where("pool", params.Pool)
+  }
+  query.Order("id asc").Find(&proxies)
+
+  if params.Tag == "" {
+    return proxies
+  }
+  var result []*models.Proxy
+  for _, proxy := range proxies {
+    var tags []string
+    json.Unmarshal(proxy.Tags, &tags)
+    for _, tag := range tags {
+      if tag == params.Tag {
+        result = append(result, proxy)
+        break
+      }
+    }
+  }
+  return result
+}
+
+func (r *ProxiesRepository) Weighted(proxies []*models.Proxy) *models.Proxy {
+  sort.Slice(proxies, func(i, j int) bool {
+    return proxies[i].Score > proxies[j].Score
+  })
+  var total float64
+  for _, proxy := range proxies {
+    total += proxy.Score + 1
+  }
+  value := rand.Float64() * total
+  for _, proxy := range proxies {
+    value -= proxy.Score + 1
+    if value < 0 {
+      return proxy
+    }
+  }
+  return proxies[0]
+}
+
+func (r *ProxiesRepository) Url(proxy *models.Proxy, timeout int) string {
+  address := &url.URL{
+    Scheme: proxy.Protocol,
+    Host:   fmt.Sprintf("%s:%d", proxy.Host, proxy.Port),
+  }
+  if proxy.Username != "" {
+    address.User = url.UserPassword(proxy.Username, proxy.Password)
+  }
+  if proxy.Protocol == "socks5" {
+    address.RawQuery = fmt.Sprintf("timeout=%ds", timeout)
+  }
+  return address.String()
+}
+
+func (r *ProxiesRepository) Report(proxy *models.Proxy, latency time.Duration, err error) {
+  if proxy == nil || proxy.ID == "" {
+    return
+  }
+
+  if err == nil {
+    ms := int(latency / time.Millisecond)
+    if ms > ProxyLatencyMax {
+      ms = ProxyLatencyMax
+    }
+    average := gorm.Expr("CASE WHEN latency = 0 THEN ? ELSE (latency * 7 + ?) / 8 END", ms, ms)
+    r.Db.Model(&models.Proxy{ID: proxy.ID}).Updates(map[string]interface{}{
+      "latency":  average,
+      "score":    gorm.Expr("score * 0.8 + 20 * (1 - (?) / ?)", average, float64(ProxyLatencyMax)),
+      "failures": 0,
+    })
+    return
+  }
+
+  r.Db.Model(&models.Proxy{ID: proxy.ID}).Updates(map[string]interface{}{
+    "score":    gorm.Expr("score * 0.8"),
+    "failures": gorm.Expr("failures + 1"),
+  })
+  r.Db.Model(&models.Proxy{}).Where(
+    "id = ? AND (failures >= ? OR score < ?)",
+    proxy.ID,
+    ProxyFailuresMax,
+    ProxyScoreMin,
+  ).Updates(map[string]interface{}{
+    "benched_until": time.Now().Add(ProxyCooldown),
+    "score":         ProxyScoreInitial,
+    "failures":      0,
+  })
+}
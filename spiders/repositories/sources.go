@@ -1,52 +1,123 @@
 package repositories
 
 import (
+  "bytes"
+  "crypto/sha1"
+  "encoding/hex"
   "encoding/json"
   "errors"
+  "fmt"
+  "html"
+  "io/ioutil"
+  "math"
+  "net/http"
   "net/url"
   "regexp"
+  "sort"
+  "strconv"
   "strings"
+  "sync"
+  "sync/atomic"
+  "text/template"
+  "time"
+  "unicode/utf8"
 
+  md "github.com/JohannesKaufmann/html-to-markdown"
   "github.com/PuerkitoBio/goquery"
+  "github.com/andybalholm/cascadia"
+  "github.com/antchfx/htmlquery"
+  "github.com/antchfx/xmlquery"
+  "github.com/antchfx/xpath"
   "github.com/hibiken/asynq"
+  "github.com/microcosm-cc/bluemonday"
   "github.com/rs/xid"
   "github.com/tidwall/gjson"
+  xhtml "golang.org/x/net/html"
+  "golang.org/x/net/html/charset"
   "gorm.io/datatypes"
   "gorm.io/gorm"
 
+  "taoniu.local/crawls/spiders/common"
   "taoniu.local/crawls/spiders/models"
   "taoniu.local/crawls/spiders/queue/asynq/jobs"
 )
 
+var (
+  articleUnlikely = regexp.MustCompile(`(?i)banner|breadcrumb|comment|community|disqus|footer|header|menu|nav|popup|related|remark|share|sidebar|social|sponsor|subscribe|tags|tool|advert|\bad-|\bads\b`)
+  articleLikely   = regexp.MustCompile(`(?i)article|body|content|entry|main|post|story|text|detail`)
+  scriptCallback  = regexp.MustCompile(`^[\w$.]+\s*\(`)
+)
+
+const TemplatesMax = 1024
+
+var (
+  rulesCache     sync.Map
+  regexpCache    sync.Map
+  xpathCache     sync.Map
+  templates      sync.Map
+  templatesCount int64
+)
+
 type SourcesRepository struct {
   Db              *gorm.DB
   Asynq           *asynq.Client
   TasksRepository *TasksRepository
 }
 
+type PreviewResult struct {
+  Result        map[string]interface{} `json:"result"`
+  Rejected      map[string]interface{} `json:"rejected"`
+  RejectedCount int                    `json:"rejected_count"`
+  Matches       map[string]interface{} `json:"matches"`
+  Urls          []string               `json:"urls"`
+}
+
+type CompiledRules struct {
+  UpdatedAt time.Time
+  Rules     map[string]*ExtractRules
+}
+
 type ExtractRules struct {
-  Html *HtmlExtractRules `json:"html"`
-  Json *JsonExtractRules `json:"json"`
+  Input    string                `json:"input"`
+  Internal bool                  `json:"internal"`
+  Html     *HtmlExtractRules     `json:"html"`
+  Json     *JsonExtractRules     `json:"json"`
+  Script   *ScriptExtractRules   `json:"script"`
+  Xml      *XmlExtractRules      `json:"xml"`
+  Article  *ArticleExtractRules  `json:"article"`
+  Metadata *MetadataExtractRules `json:"metadata"`
+  Schema   *ExtractSchema        `json:"schema"`
 }
 
 type HtmlExtractRules struct {
   Container *HtmlExtractNode    `json:"container"`
   List      *HtmlExtractNode    `json:"list"`
   Fields    []*HtmlExtractField `json:"fields"`
+  Computed  []*ComputedField    `json:"computed"`
+  Filters   []*Filter           `json:"filters"`
 }
 
 type HtmlExtractNode struct {
-  Selector string `json:"selector"`
-  Attr     string `json:"attr"`
-  Index    int    `json:"index"`
+  Selector  string   `json:"selector"`
+  Attr      string   `json:"attr"`
+  Index     int      `json:"index"`
+  Type      string   `json:"type"`
+  Resolve   bool     `json:"resolve"`
+  All       bool     `json:"all"`
+  Fallbacks []string `json:"fallbacks"`
 }
 
 type HtmlExtractField struct {
   Name         string              `json:"name"`
   Node         *HtmlExtractNode    `json:"node"`
+  Output       string              `json:"output"`
+  AllowTags    []string            `json:"allow_tags"`
   Match        string              `json:"match"`
+  Metadata     string              `json:"metadata"`
   RegexReplace []*RegexReplace     `json:"regex_replace"`
   TextReplace  []*TextReplace      `json:"text_replace"`
+  RegexExtract *RegexExtract       `json:"regex_extract"`
+  Transforms   []*Transform        `json:"transforms"`
   Fields       []*HtmlExtractField `json:"fields"`
 }
 
@@ -55,23 +126,117 @@ type RegexReplace struct {
   Value   string `json:"value"`
 }
 
+type RegexExtract struct {
+  Pattern string `json:"pattern"`
+  Group   string `json:"group"`
+  All     bool   `json:"all"`
+  Miss    string `json:"miss"`
+}
+
+type ComputedField struct {
+  Name     string `json:"name"`
+  Template string `json:"template"`
+}
+
+type Filter struct {
+  Field string    `json:"field"`
+  Op    string    `json:"op"`
+  Value string    `json:"value"`
+  All   []*Filter `json:"all"`
+  Any   []*Filter `json:"any"`
+  Not   *Filter   `json:"not"`
+}
+
 type TextReplace struct {
   Text  string `json:"text"`
   Value string `json:"value"`
 }
 
+type Transform struct {
+  Type      string `json:"type"`
+  Layout    string `json:"layout"`
+  Timezone  string `json:"timezone"`
+  Separator string `json:"separator"`
+  Value     string `json:"value"`
+}
+
+type ExtractSchema struct {
+  Fields  []*SchemaField `json:"fields"`
+  Invalid string         `json:"invalid"`
+}
+
+type SchemaField struct {
+  Name     string `json:"name"`
+  Type     string `json:"type"`
+  Required bool   `json:"required"`
+  Default  string `json:"default"`
+}
+
+type ArticleExtractRules struct {
+  Output   string              `json:"output"`
+  Fields   []*HtmlExtractField `json:"fields"`
+  Computed []*ComputedField    `json:"computed"`
+}
+
+type MetadataExtractRules struct {
+  Include []string `json:"include"`
+}
+
+type ScriptExtractRules struct {
+  Node     *HtmlExtractNode  `json:"node"`
+  Variable string            `json:"variable"`
+  Json     *JsonExtractRules `json:"json"`
+}
+
+type XmlExtractRules struct {
+  Preset     string             `json:"preset"`
+  Namespaces map[string]string  `json:"namespaces"`
+  Container  string             `json:"container"`
+  List       string             `json:"list"`
+  Fields     []*XmlExtractField `json:"fields"`
+  Filters    []*Filter          `json:"filters"`
+}
+
+type XmlExtractField struct {
+  Name         string             `json:"name"`
+  Path         string             `json:"path"`
+  Match        string             `json:"match"`
+  RegexReplace []*RegexReplace    `json:"regex_replace"`
+  TextReplace  []*TextReplace     `json:"text_replace"`
+  RegexExtract *RegexExtract      `json:"regex_extract"`
+  Transforms   []*Transform       `json:"transforms"`
+  Fields       []*XmlExtractField `json:"fields"`
+}
+
+type ExtractPage struct {
+  Url      string
+  BaseUrl  *url.URL
+  Time     time.Time
+  Location *time.Location
+  Rule     string
+  Matches  map[string]interface{}
+  Metadata []byte
+}
+
 type JsonExtractRules struct {
-  Container string              `json:"node"`
-  List      string              `json:"list"`
-  Fields    []*JsonExtractField `json:"fields"`
+  Container          string              `json:"node"`
+  ContainerFallbacks []string            `json:"container_fallbacks"`
+  List               string              `json:"list"`
+  ListFallbacks      []string            `json:"list_fallbacks"`
+  Fields             []*JsonExtractField `json:"fields"`
+  Computed           []*ComputedField    `json:"computed"`
+  Filters            []*Filter           `json:"filters"`
 }
 
 type JsonExtractField struct {
   Name         string              `json:"name"`
   Path         string              `json:"path"`
+  Fallbacks    []string            `json:"fallbacks"`
   Match        string              `json:"match"`
   RegexReplace []*RegexReplace     `json:"regex_replace"`
   TextReplace  []*TextReplace      `json:"text_replace"`
+  RegexExtract *RegexExtract       `json:"regex_extract"`
+  Transforms   []*Transform        `json:"transforms"`
   Fields       []*JsonExtractField `json:"fields"`
 }
 
@@ -128,8 +293,21 @@ func (r *SourcesRepository) Save(
   params map[string]interface{},
   useProxy bool,
   timeout int,
+  method string,
+  body string,
+  contentType string,
   extractRules map[string]*ExtractRules,
 ) error {
+  err := r.ValidateRequest(method, body)
+  if err != nil {
+    return err
+  }
+
+  err = r.ValidateRules(params, extractRules)
+  if err != nil {
+    return err
+  }
+
   var entity *models.Source
   result := r.Db.Where("slug", slug).Take(&entity)
   if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -143,6 +321,9 @@ func (r *SourcesRepository) Save(
       Params:       r.JSONMap(params),
       UseProxy:     useProxy,
       Timeout:      timeout,
+      Method:       method,
+      Body:         body,
+      ContentType:  contentType,
       ExtractRules: r.JSONMap(extractRules),
     }
     r.Db.Create(&entity)
@@ -154,8 +335,12 @@ func (r *SourcesRepository) Save(
     entity.Params = r.JSONMap(params)
     entity.UseProxy = useProxy
     entity.Timeout = timeout
+    entity.Method = method
+    entity.Body = body
+    entity.ContentType = contentType
     entity.ExtractRules = r.JSONMap(extractRules)
     r.Db.Model(&models.Source{ID: entity.ID}).Updates(entity)
+    rulesCache.Delete(entity.ID)
   }
 
   return nil
@@ -176,30 +361,9 @@ func (r *SourcesRepository) Flush(source *models.Source) error {
   }
   if items, ok := source.Params["split"].([]interface{}); ok {
     for _, split := range items {
-      gjson.GetBytes(content, split.(string)).ForEach(func(_, s gjson.Result) bool {
-        url, err := url.Parse(source.Url)
-        if err != nil {
-          return false
-        }
-        values := url.Query()
-        if items, ok := source.Params["query"].([]interface{}); ok {
-          for _, item := range items {
-            item := item.(map[string]interface{})
-            name := item["name"].(string)
-            value := item["value"].(string)
-            if value == "$0" {
-              value = s.Value().(string)
-            }
-            if value == "$1" {
-              continue
-            }
-            values[name] = []string{value}
-          }
-        }
-        url.RawQuery = values.Encode()
-        r.Tasks().Save("", source.ID, url.String())
-        return true
-      })
+      for _, url := range r.Split(source, content, split.(string), r.Page(task.Url, nil)) {
+        r.Tasks().Save("", source.ID, url)
+      }
     }
   }
   //source.ExtractResult = r.JSONMap(result)
@@ -209,195 +373,2603 @@ func (r *SourcesRepository) Flush(source *models.Source) error {
   return nil
 }
 
-func (r *SourcesRepository) ExtractHtml(doc *goquery.Document, rules *HtmlExtractRules) (data map[string]interface{}, err error) {
-  var container = doc.Find(rules.Container.Selector).Eq(rules.Container.Index)
+func (r *SourcesRepository) Split(source *models.Source, content []byte, path string, page *ExtractPage) []string {
+  var urls []string
+  gjson.GetBytes(content, path).ForEach(func(_, s gjson.Result) bool {
+    data := map[string]interface{}{}
+    if s.IsObject() {
+      if item, ok := s.Value().(map[string]interface{}); ok {
+        data = item
+      }
+    }
+    data["value"] = s.Value()
+
+    sourceUrl, err := r.Template(source.Url, data, page)
+    if err != nil {
+      return true
+    }
+    if strings.Contains(sourceUrl, "{}") {
+      sourceUrl = strings.Replace(sourceUrl, "{}", s.String(), 1)
+    }
+
+    url, err := url.Parse(sourceUrl)
+    if err != nil {
+      return true
+    }
+    values := url.Query()
+    if items, ok := source.Params["query"].([]interface{}); ok {
+      for _, item := range items {
+        item := item.(map[string]interface{})
+        name := item["name"].(string)
+        value := item["value"].(string)
+        if value == "$1" {
+          continue
+        }
+        if value == "$0" {
+          value = s.String()
+        } else {
+          value, err = r.Template(value, data, page)
+          if err != nil {
+            return true
+          }
+        }
+        values[name] = []string{value}
+      }
+    }
+    url.RawQuery = values.Encode()
+    urls = append(urls, url.String())
+    return true
+  })
+  return urls
+}
+
+func (r *SourcesRepository) Compute(data map[string]interface{}, computed []*ComputedField, page *ExtractPage) {
+  for _, field := range computed {
+    value, err := r.Template(field.Template, data, page)
+    if err != nil {
+      continue
+    }
+    data[field.Name] = value
+  }
+}
+
+func (r *SourcesRepository) Template(text string, data map[string]interface{}, page *ExtractPage) (string, error) {
+  if !strings.Contains(text, "{{") {
+    return text, nil
+  }
+
+  tmpl, err := r.ParseTemplate(text)
+  if err != nil {
+    return "", err
+  }
+  tmpl, err = tmpl.Clone()
+  if err != nil {
+    return "", err
+  }
+  tmpl.Funcs(r.TemplateFuncs(page))
+
+  var buf bytes.Buffer
+  err = tmpl.Execute(&buf, data)
+  if err != nil {
+    return "", err
+  }
+  return buf.String(), nil
+}
+
+func (r *SourcesRepository) ParseTemplate(text string) (*template.Template, error) {
+  if tmpl, ok := templates.Load(text); ok {
+    return tmpl.(*template.Template), nil
+  }
+  tmpl, err := template.New("").Option("missingkey=error").Funcs(r.TemplateFuncs(nil)).Parse(text)
+  if err != nil {
+    return nil, err
+  }
+  if atomic.LoadInt64(&templatesCount) < TemplatesMax {
+    if _, loaded := templates.LoadOrStore(text, tmpl); !loaded {
+      atomic.AddInt64(&templatesCount, 1)
+    }
+  }
+  return tmpl, nil
+}
+
+func (r *SourcesRepository) TemplateFuncs(page *ExtractPage) template.FuncMap {
+  if page == nil {
+    page = &ExtractPage{
+      Time: time.Now(),
+    }
+  }
+  return template.FuncMap{
+    "url": func() string {
+      return page.Url
+    },
+    "fetched": func(layout ...string) string {
+      if len(layout) > 0 {
+        return page.Time.Format(layout[0])
+      }
+      return page.Time.Format(time.RFC3339)
+    },
+    "resolve": func(link interface{}) (string, error) {
+      return r.Resolve(page, fmt.Sprintf("%v", link))
+    },
+    "lower": func(value interface{}) string {
+      return strings.ToLower(fmt.Sprintf("%v", value))
+    },
+    "upper": func(value interface{}) string {
+      return strings.ToUpper(fmt.Sprintf("%v", value))
+    },
+    "trim": func(value interface{}) string {
+      return strings.TrimSpace(fmt.Sprintf("%v", value))
+    },
+    "replace": func(old string, new string, value interface{}) string {
+      return strings.ReplaceAll(fmt.Sprintf("%v", value), old, new)
+    },
+    "join": func(separator string, value interface{}) string {
+      items, ok := value.([]interface{})
+      if !ok {
+        return fmt.Sprintf("%v", value)
+      }
+      var texts []string
+      for _, item := range items {
+        texts = append(texts, fmt.Sprintf("%v", item))
+      }
+      return strings.Join(texts, separator)
+    },
+    "default": func(fallback interface{}, value interface{}) interface{} {
+      if r.SchemaEmpty(value) {
+        return fallback
+      }
+      return value
+    },
+  }
+}
+
+func (r *SourcesRepository) Preview(
+  source *models.Source,
+  extractRules map[string]*ExtractRules,
+  url string,
+  content string,
+) (*PreviewResult, error) {
+  if url == "" {
+    url = source.Url
+  }
+
+  body := []byte(content)
+  if content == "" {
+    request, _, err := r.Tasks().FetchRequest(source, url, nil)
+    if err != nil {
+      return nil, err
+    }
+    resp, err := r.Tasks().Fetch().Fetch(request)
+    if err != nil {
+      return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+      return nil, errors.New(
+        fmt.Sprintf(
+          "request error: status[%s] code[%d]",
+          resp.Status,
+          resp.StatusCode,
+        ),
+      )
+    }
+
+    body, err = ioutil.ReadAll(resp.Body)
+    if err != nil {
+      return nil, err
+    }
+
+    body, err = r.Tasks().Transcode(source, resp.Header.Get("Content-Type"), body)
+    if err != nil {
+      return nil, err
+    }
+  }
+
+  output, err := r.Tasks().Extract(source, extractRules, url, body)
+  if err != nil {
+    return nil, err
+  }
+
+  result := &PreviewResult{
+    Result:        output.Result,
+    Rejected:      output.Rejected,
+    RejectedCount: output.RejectedCount,
+    Matches:       output.Matches,
+  }
+
+  scroll, err := r.Tasks().Scroll(source, url, output.Result)
+  if err != nil {
+    return nil, err
+  }
+  if scroll != "" {
+    result.Urls = append(result.Urls, scroll)
+  }
+
+  if source.Slug == "" {
+    return result, nil
+  }
+  data, err := json.Marshal(output.Result)
+  if err != nil {
+    return nil, err
+  }
+  for _, child := range r.All([]string{"id", "slug", "url", "params"}) {
+    split, ok := child.Params["split"].(map[string]interface{})
+    if !ok {
+      continue
+    }
+    paths, ok := split[source.Slug].([]interface{})
+    if !ok {
+      continue
+    }
+    for _, path := range paths {
+      result.Urls = append(result.Urls, r.Split(child, data, path.(string), r.Page(url, nil))...)
+    }
+  }
+
+  return result, nil
+}
+
+func (r *SourcesRepository) ExtractHtml(doc *goquery.Document, rules *HtmlExtractRules, page *ExtractPage) (data map[string]interface{}, err error) {
+  container, err := r.SelectNode(doc.Selection, rules.Container, page)
+  if err != nil {
+    return
+  }
+  container = container.Eq(rules.Container.Index)
   if container.Nodes == nil {
     err = errors.New("container not exists")
     return
   }
 
-  data, err = r.ExtractHtmlFields(container, rules.Fields)
+  data, err = r.ExtractHtmlFields(container, rules.Fields, page)
   if err != nil {
     return
   }
+  r.Compute(data, rules.Computed, page)
 
   return
 }
 
-func (r *SourcesRepository) ExtractHtmlList(doc *goquery.Document, rules *HtmlExtractRules) (result []map[string]interface{}, err error) {
-  var container = doc.Find(rules.Container.Selector).Eq(rules.Container.Index)
+func (r *SourcesRepository) ExtractHtmlList(doc *goquery.Document, rules *HtmlExtractRules, page *ExtractPage) (result []map[string]interface{}, err error) {
+  container, err := r.SelectNode(doc.Selection, rules.Container, page)
+  if err != nil {
+    return
+  }
+  container = container.Eq(rules.Container.Index)
   if container.Nodes == nil {
     err = errors.New("container not exists")
     return
   }
 
-  container.Find(rules.List.Selector).Each(func(i int, s *goquery.Selection) {
-    data, err := r.ExtractHtmlFields(s, rules.Fields)
+  list, err := r.SelectNode(container, rules.List, page)
+  if err != nil {
+    return
+  }
+
+  list.Each(func(i int, s *goquery.Selection) {
+    data, err := r.ExtractHtmlFields(s, rules.Fields, page)
     if err != nil {
       return
     }
+    r.Compute(data, rules.Computed, page)
+    if !r.Filter(data, rules.Filters) {
+      return
+    }
     result = append(result, data)
   })
 
   return
 }
 
-func (r *SourcesRepository) ExtractHtmlFields(s *goquery.Selection, fields []*HtmlExtractField) (data map[string]interface{}, err error) {
+func (r *SourcesRepository) ExtractHtmlFields(s *goquery.Selection, fields []*HtmlExtractField, page *ExtractPage) (data map[string]interface{}, err error) {
   data = make(map[string]interface{})
   for _, field := range fields {
+    selection := s
+    missing := false
     if field.Node.Selector != "" {
-      selection := s.Find(field.Node.Selector).Eq(field.Node.Index)
-      if selection.Nodes == nil {
-        continue
+      selection, err = r.SelectNode(s, field.Node, page)
+      if err != nil {
+        return
       }
-      if field.Node.Attr != "" {
-        data[field.Name], _ = selection.Attr(field.Node.Attr)
-      } else {
-        data[field.Name] = selection.Text()
+      if !field.Node.All {
+        selection = selection.Eq(field.Node.Index)
       }
-    } else {
-      if field.Node.Attr != "" {
-        attr, exists := s.Attr(field.Node.Attr)
-        if !exists {
+      missing = selection.Nodes == nil
+    } else if field.Node.Attr != "" {
+      _, exists := s.Attr(field.Node.Attr)
+      missing = !exists
+    }
+
+    if missing {
+      value, ok := r.MetadataValue(s, field.Metadata, page)
+      if !ok {
+        if value, ok := r.MissingValue(field.Transforms, page); ok {
+          data[field.Name] = value
+        }
+        continue
+      }
+      data[field.Name] = value
+    } else if field.Node.All {
+      var values []interface{}
+      for i := range selection.Nodes {
+        value, err := r.HtmlValue(selection.Eq(i), field, page)
+        if err != nil {
           continue
         }
-        data[field.Name] = attr
-      } else {
-        data[field.Name] = s.Text()
+        values = append(values, value)
       }
-    }
-
-    if len(field.Fields) > 0 {
-      result, err := r.ExtractHtmlFields(s, field.Fields)
+      data[field.Name] = values
+    } else {
+      value, err := r.HtmlValue(selection, field, page)
       if err != nil {
         continue
       }
-      data[field.Name] = result
+      data[field.Name] = value
     }
 
-    for _, replace := range field.RegexReplace {
-      m := regexp.MustCompile(replace.Pattern)
-      data[field.Name] = m.ReplaceAllString(data[field.Name].(string), replace.Value)
-    }
-    for _, replace := range field.TextReplace {
-      data[field.Name] = strings.ReplaceAll(data[field.Name].(string), replace.Text, replace.Value)
+    data[field.Name] = r.Replace(data[field.Name], field.RegexReplace, field.TextReplace)
+
+    if field.RegexExtract != nil {
+      var matched bool
+      data[field.Name], matched, err = r.RegexExtract(data[field.Name], field.RegexExtract)
+      if err != nil {
+        return
+      }
+      if !matched {
+        switch field.RegexExtract.Miss {
+        case "null":
+          data[field.Name] = nil
+          continue
+        case "skip":
+          err = errors.New("field not extracted")
+          return
+        }
+        delete(data, field.Name)
+        continue
+      }
     }
 
     if field.Match != "" && field.Match != data[field.Name] {
       err = errors.New("field not match")
       return
     }
+
+    if len(field.Transforms) > 0 {
+      value, err := r.Transform(data[field.Name], field.Transforms, page)
+      if err != nil {
+        delete(data, field.Name)
+        continue
+      }
+      data[field.Name] = value
+    }
   }
 
   return
 }
 
-func (r *SourcesRepository) ExtractJson(content string, rules *JsonExtractRules) (map[string]interface{}, error) {
-  var container = gjson.Get(content, rules.Container)
-  if container.Raw == "" {
-    return nil, errors.New("container not exists")
+func (r *SourcesRepository) HtmlValue(s *goquery.Selection, field *HtmlExtractField, page *ExtractPage) (interface{}, error) {
+  if len(field.Fields) > 0 {
+    return r.ExtractHtmlFields(s, field.Fields, page)
+  }
+  if field.Node.Attr != "" {
+    return r.Attr(s, field.Node, page)
   }
+  return r.Output(s, field)
+}
 
-  var data = make(map[string]interface{})
-  for _, field := range rules.Fields {
-    selection := container.Get(field.Path)
-    if selection.Raw == "" {
-      continue
+func (r *SourcesRepository) Replace(value interface{}, regexReplace []*RegexReplace, textReplace []*TextReplace) interface{} {
+  if items, ok := value.([]interface{}); ok {
+    var result []interface{}
+    for _, item := range items {
+      result = append(result, r.Replace(item, regexReplace, textReplace))
     }
-    data[field.Name] = selection.Value()
-
-    if value, ok := data[field.Name].(string); ok {
-      for _, replace := range field.RegexReplace {
-        m := regexp.MustCompile(replace.Pattern)
-        data[field.Name] = m.ReplaceAllString(value, replace.Value)
-      }
-      for _, replace := range field.TextReplace {
-        data[field.Name] = strings.ReplaceAll(value, replace.Text, replace.Value)
-      }
+    return result
+  }
 
-      if field.Match != "" && field.Match != data[field.Name] {
-        return nil, errors.New("field not match")
-      }
+  text, ok := value.(string)
+  if !ok {
+    return value
+  }
+  for _, replace := range regexReplace {
+    m, err := r.Regexp(replace.Pattern)
+    if err != nil {
+      continue
     }
+    text = m.ReplaceAllString(text, replace.Value)
   }
-
-  return data, nil
+  for _, replace := range textReplace {
+    text = strings.ReplaceAll(text, replace.Text, replace.Value)
+  }
+  return text
 }
 
-func (r *SourcesRepository) ExtractJsonList(content string, rules *JsonExtractRules) (result []map[string]interface{}, err error) {
-  if rules.Container != "" {
-    var container = gjson.Get(content, rules.Container)
-    if container.Raw == "" {
-      err = errors.New("container not exists")
-      return
+func (r *SourcesRepository) Page(rawUrl string, doc *goquery.Document) *ExtractPage {
+  page := &ExtractPage{
+    Url:  rawUrl,
+    Time: time.Now(),
+  }
+  page.BaseUrl, _ = url.Parse(rawUrl)
+  if doc == nil || page.BaseUrl == nil {
+    return page
+  }
+  if href, exists := doc.Find("base[href]").First().Attr("href"); exists {
+    base, err := page.BaseUrl.Parse(strings.TrimSpace(href))
+    if err == nil {
+      page.BaseUrl = base
     }
-
-    container.Get(rules.List).ForEach(func(_, s gjson.Result) bool {
-      data, err := r.ExtractJsonFields(&s, rules.Fields)
-      if err != nil {
-        return false
-      }
-      result = append(result, data)
-      return true
-    })
-  } else {
-    gjson.Get(content, rules.List).ForEach(func(_, s gjson.Result) bool {
-      data, err := r.ExtractJsonFields(&s, rules.Fields)
-      if err != nil {
-        return false
-      }
-      result = append(result, data)
-      return true
-    })
   }
-
-  return
+  return page
 }
 
-func (r *SourcesRepository) ExtractJsonFields(s *gjson.Result, fields []*JsonExtractField) (data map[string]interface{}, err error) {
-  data = make(map[string]interface{})
-  for _, field := range fields {
-    selection := s.Get(field.Path)
-    if selection.Raw == "" {
+func (r *SourcesRepository) Attr(s *goquery.Selection, node *HtmlExtractNode, page *ExtractPage) (string, error) {
+  value, _ := s.Attr(node.Attr)
+  if !node.Resolve || value == "" {
+    return value, nil
+  }
+  if node.Attr != "srcset" {
+    return r.Resolve(page, value)
+  }
+
+  var items []string
+  for _, item := range strings.Split(value, ",") {
+    parts := strings.Fields(item)
+    if len(parts) == 0 {
       continue
     }
-    data[field.Name] = selection.Value()
-
-    if len(field.Fields) > 0 {
-      if selection.IsArray() {
-        var result []map[string]interface{}
-        selection.ForEach(func(_, s gjson.Result) bool {
-          data, err := r.ExtractJsonFields(&s, field.Fields)
-          if err != nil {
-            return false
-          }
-          result = append(result, data)
-          return true
-        })
-        data[field.Name] = result
-      } else {
-        result, err := r.ExtractJsonFields(&selection, field.Fields)
-        if err != nil {
-          continue
-        }
-        data[field.Name] = result
-      }
+    link, err := r.Resolve(page, parts[0])
+    if err != nil {
+      return "", err
     }
+    parts[0] = link
+    items = append(items, strings.Join(parts, " "))
+  }
+  return strings.Join(items, ", "), nil
+}
+
+func (r *SourcesRepository) Resolve(page *ExtractPage, link string) (string, error) {
+  link = strings.TrimSpace(link)
+  if page == nil || page.BaseUrl == nil {
+    return link, nil
+  }
+  ref, err := url.Parse(link)
+  if err != nil {
+    return "", err
+  }
+  return page.BaseUrl.ResolveReference(ref).String(), nil
+}
 
-    for _, replace := range field.RegexReplace {
-      m := regexp.MustCompile(replace.Pattern)
-      data[field.Name] = m.ReplaceAllString(data[field.Name].(string), replace.Value)
+func (r *SourcesRepository) Output(s *goquery.Selection, field *HtmlExtractField) (string, error) {
+  switch field.Output {
+  case "html":
+    return s.Html()
+  case "outer_html":
+    return goquery.OuterHtml(s)
+  case "sanitized_html":
+    content, err := s.Html()
+    if err != nil {
+      return "", err
     }
-    for _, replace := range field.TextReplace {
-      data[field.Name] = strings.ReplaceAll(data[field.Name].(string), replace.Text, replace.Value)
+    return r.Sanitize(content, field.AllowTags), nil
+  case "markdown":
+    content, err := s.Html()
+    if err != nil {
+      return "", err
     }
+    converter := md.NewConverter("", true, nil)
+    return converter.ConvertString(content)
+  }
 
-    if field.Match != "" && field.Match != data[field.Name] {
-      err = errors.New("field not match")
+  return s.Text(), nil
+}
+
+func (r *SourcesRepository) Sanitize(content string, tags []string) string {
+  if len(tags) == 0 {
+    return bluemonday.UGCPolicy().Sanitize(content)
+  }
+
+  policy := bluemonday.NewPolicy()
+  policy.AllowElements(tags...)
+  policy.AllowStandardURLs()
+  for _, tag := range tags {
+    switch tag {
+    case "a":
+      policy.AllowAttrs("href", "title").OnElements("a")
+    case "img":
+      policy.AllowAttrs("src", "alt", "title").OnElements("img")
+    }
+  }
+
+  return policy.Sanitize(content)
+}
+
+func (r *SourcesRepository) SelectNode(s *goquery.Selection, node *HtmlExtractNode, page *ExtractPage) (*goquery.Selection, error) {
+  selection, err := r.Select(s, node)
+  if err != nil || selection.Length() > 0 || len(node.Fallbacks) == 0 {
+    return selection, err
+  }
+  for _, selector := range node.Fallbacks {
+    fallback := *node
+    fallback.Selector = selector
+    selection, err = r.Select(s, &fallback)
+    if err != nil {
+      return nil, err
+    }
+    if selection.Length() > 0 {
+      r.Match(page, node.Selector, selector)
+      return selection, nil
+    }
+  }
+  return selection, nil
+}
+
+func (r *SourcesRepository) Match(page *ExtractPage, primary string, matched string) {
+  if page == nil || page.Matches == nil {
+    return
+  }
+  matches, ok := page.Matches[page.Rule].(map[string]interface{})
+  if !ok {
+    matches = map[string]interface{}{}
+    page.Matches[page.Rule] = matches
+  }
+  matches[primary] = matched
+}
+
+func (r *SourcesRepository) Select(s *goquery.Selection, node *HtmlExtractNode) (*goquery.Selection, error) {
+  if node.Type != "xpath" {
+    return s.Find(node.Selector), nil
+  }
+
+  var nodes []*xhtml.Node
+  for _, n := range s.Nodes {
+    items, err := htmlquery.QueryAll(n, node.Selector)
+    if err != nil {
+      return nil, err
+    }
+    nodes = append(nodes, items...)
+  }
+
+  return s.FindNodes().AddNodes(nodes...), nil
+}
+
+func (r *SourcesRepository) GetPath(s *gjson.Result, field *JsonExtractField, page *ExtractPage) gjson.Result {
+  return r.Lookup(s, field.Path, field.Fallbacks, page)
+}
+
+func (r *SourcesRepository) Lookup(s *gjson.Result, primary string, fallbacks []string, page *ExtractPage) gjson.Result {
+  selection := s.Get(primary)
+  if selection.Raw != "" || len(fallbacks) == 0 {
+    return selection
+  }
+  for _, path := range fallbacks {
+    selection = s.Get(path)
+    if selection.Raw != "" {
+      r.Match(page, primary, path)
+      return selection
+    }
+  }
+  return selection
+}
+
+func (r *SourcesRepository) Filter(data map[string]interface{}, filters []*Filter) bool {
+  for _, filter := range filters {
+    if !r.FilterItem(data, filter) {
+      return false
+    }
+  }
+  return true
+}
+
+func (r *SourcesRepository) FilterItem(data map[string]interface{}, filter *Filter) bool {
+  if len(filter.All) > 0 && !r.Filter(data, filter.All) {
+    return false
+  }
+  if len(filter.Any) > 0 {
+    matched := false
+    for _, item := range filter.Any {
+      if r.FilterItem(data, item) {
+        matched = true
+        break
+      }
+    }
+    if !matched {
+      return false
+    }
+  }
+  if filter.Not != nil && r.FilterItem(data, filter.Not) {
+    return false
+  }
+  if filter.Op == "" {
+    return true
+  }
+
+  value, exists := data[filter.Field]
+  if !exists && strings.Contains(filter.Field, ".") {
+    content, _ := json.Marshal(data)
+    result := gjson.GetBytes(content, filter.Field)
+    value, exists = result.Value(), result.Exists()
+  }
+  exists = exists && value != nil
+
+  switch filter.Op {
+  case "exists":
+    return exists
+  case "not_exists":
+    return !exists
+  case "ne":
+    return !exists || !r.FilterEqual(value, filter.Value)
+  case "not_contains":
+    return !exists || !r.FilterContains(value, filter.Value)
+  }
+  if !exists {
+    return false
+  }
+
+  switch filter.Op {
+  case "eq":
+    return r.FilterEqual(value, filter.Value)
+  case "contains":
+    return r.FilterContains(value, filter.Value)
+  case "regex":
+    m, err := r.Regexp(filter.Value)
+    if err != nil {
+      return false
+    }
+    return m.MatchString(fmt.Sprintf("%v", value))
+  case "gt", "gte", "lt", "lte":
+    number, err := strconv.ParseFloat(strings.TrimSpace(fmt.Sprintf("%v", value)), 64)
+    if err != nil {
+      return false
+    }
+    target, err := strconv.ParseFloat(filter.Value, 64)
+    if err != nil {
+      return false
+    }
+    switch filter.Op {
+    case "gt":
+      return number > target
+    case "gte":
+      return number >= target
+    case "lt":
+      return number < target
+    }
+    return number <= target
+  }
+
+  return false
+}
+
+func (r *SourcesRepository) FilterEqual(value interface{}, text string) bool {
+  return fmt.Sprintf("%v", value) == text
+}
+
+func (r *SourcesRepository) FilterContains(value interface{}, text string) bool {
+  if items, ok := value.([]interface{}); ok {
+    for _, item := range items {
+      if r.FilterEqual(item, text) {
+        return true
+      }
+    }
+    return false
+  }
+  return strings.Contains(fmt.Sprintf("%v", value), text)
+}
+
+func (r *SourcesRepository) ExtractJson(content string, rules *JsonExtractRules, page *ExtractPage) (map[string]interface{}, error) {
+  container := gjson.Parse(content)
+  if rules.Container != "" {
+    container = r.Lookup(&container, rules.Container, rules.ContainerFallbacks, page)
+  }
+  if container.Raw == "" {
+    return nil, errors.New("container not exists")
+  }
+
+  data, err := r.ExtractJsonFields(&container, rules.Fields, page)
+  if err != nil {
+    return nil, err
+  }
+  r.Compute(data, rules.Computed, page)
+
+  return data, nil
+}
+
+func (r *SourcesRepository) ExtractJsonList(content string, rules *JsonExtractRules, page *ExtractPage) (result []map[string]interface{}, err error) {
+  container := gjson.Parse(content)
+  if rules.Container != "" {
+    container = r.Lookup(&container, rules.Container, rules.ContainerFallbacks, page)
+    if container.Raw == "" {
+      err = errors.New("container not exists")
+      return
+    }
+  }
+
+  r.Lookup(&container, rules.List, rules.ListFallbacks, page).ForEach(func(_, s gjson.Result) bool {
+    data, err := r.ExtractJsonFields(&s, rules.Fields, page)
+    if err != nil {
+      return true
+    }
+    r.Compute(data, rules.Computed, page)
+    if !r.Filter(data, rules.Filters) {
+      return true
+    }
+    result = append(result, data)
+    return true
+  })
+
+  return
+}
+
+func (r *SourcesRepository) ExtractJsonFields(s *gjson.Result, fields []*JsonExtractField, page *ExtractPage) (data map[string]interface{}, err error) {
+  data = make(map[string]interface{})
+  for _, field := range fields {
+    selection := r.GetPath(s, field, page)
+    if selection.Raw == "" {
+      if value, ok := r.MissingValue(field.Transforms, page); ok {
+        data[field.Name] = value
+      }
+      continue
+    }
+    data[field.Name] = selection.Value()
+
+    if len(field.Fields) > 0 {
+      if selection.IsArray() {
+        var result []map[string]interface{}
+        selection.ForEach(func(_, s gjson.Result) bool {
+          data, err := r.ExtractJsonFields(&s, field.Fields, page)
+          if err != nil {
+            return false
+          }
+          result = append(result, data)
+          return true
+        })
+        data[field.Name] = result
+      } else {
+        result, err := r.ExtractJsonFields(&selection, field.Fields, page)
+        if err != nil {
+          continue
+        }
+        data[field.Name] = result
+      }
+    }
+
+    data[field.Name] = r.Replace(data[field.Name], field.RegexReplace, field.TextReplace)
+
+    if field.RegexExtract != nil {
+      var matched bool
+      data[field.Name], matched, err = r.RegexExtract(data[field.Name], field.RegexExtract)
+      if err != nil {
+        return
+      }
+      if !matched {
+        switch field.RegexExtract.Miss {
+        case "null":
+          data[field.Name] = nil
+          continue
+        case "skip":
+          err = errors.New("field not extracted")
+          return
+        }
+        delete(data, field.Name)
+        continue
+      }
+    }
+
+    if field.Match != "" && field.Match != data[field.Name] {
+      err = errors.New("field not match")
+      return
+    }
+
+    if len(field.Transforms) > 0 {
+      value, err := r.Transform(data[field.Name], field.Transforms, page)
+      if err != nil {
+        delete(data, field.Name)
+        continue
+      }
+      data[field.Name] = value
+    }
+  }
+
+  return
+}
+
+func (r *SourcesRepository) ExtractScript(doc *goquery.Document, rules *ScriptExtractRules, page *ExtractPage) (interface{}, error) {
+  var scripts *goquery.Selection
+  if rules.Node != nil && rules.Node.Selector != "" {
+    selection, err := r.SelectNode(doc.Selection, rules.Node, page)
+    if err != nil {
+      return nil, err
+    }
+    scripts = selection
+  } else {
+    scripts = doc.Find("script")
+  }
+
+  var content string
+  for i := range scripts.Nodes {
+    data, err := r.ScriptJson(scripts.Eq(i).Text(), rules.Variable)
+    if err == nil {
+      content = data
+      break
+    }
+  }
+  if content == "" {
+    return nil, errors.New("script not exists")
+  }
+
+  if rules.Json.List != "" {
+    return r.ExtractJsonList(content, rules.Json, page)
+  }
+  return r.ExtractJson(content, rules.Json, page)
+}
+
+func (r *SourcesRepository) ExtractArticle(doc *goquery.Document, rules *ArticleExtractRules, page *ExtractPage) (map[string]interface{}, error) {
+  data := map[string]interface{}{}
+
+  meta := r.ArticleMeta(doc, page)
+  for key, value := range meta {
+    data[key] = value
+  }
+
+  content, err := r.ArticleContent(doc)
+  if err == nil {
+    content.Find("img[src], a[href]").Each(func(i int, s *goquery.Selection) {
+      attr := "src"
+      if goquery.NodeName(s) == "a" {
+        attr = "href"
+      }
+      link, _ := s.Attr(attr)
+      if link, err := r.Resolve(page, link); err == nil {
+        s.SetAttr(attr, link)
+      }
+    })
+    if _, ok := data["image"]; !ok {
+      if src, exists := content.Find("img[src]").First().Attr("src"); exists {
+        data["image"] = src
+      }
+    }
+    output := rules.Output
+    if output == "" {
+      output = "sanitized_html"
+    }
+    data["html"], _ = r.Output(content, &HtmlExtractField{Output: output})
+    data["text"] = r.ArticleText(content)
+  }
+
+  if len(rules.Fields) > 0 {
+    fields, err := r.ExtractHtmlFields(doc.Selection, rules.Fields, page)
+    if err != nil {
+      return nil, err
+    }
+    for key, value := range fields {
+      data[key] = value
+    }
+  }
+
+  if data["title"] == nil && data["text"] == nil {
+    return nil, errors.New("article not exists")
+  }
+  r.Compute(data, rules.Computed, page)
+
+  return data, nil
+}
+
+func (r *SourcesRepository) ArticleMeta(doc *goquery.Document, page *ExtractPage) map[string]interface{} {
+  data := map[string]interface{}{}
+  metadata := r.Metadata(doc, nil, page)
+  first := func(selectors ...string) string {
+    for _, selector := range selectors {
+      s := doc.Find(selector).First()
+      if s.Length() == 0 {
+        continue
+      }
+      value, exists := s.Attr("datetime")
+      if !exists {
+        value = s.Text()
+      }
+      value = strings.Join(strings.Fields(value), " ")
+      if value != "" {
+        return value
+      }
+    }
+    return ""
+  }
+
+  if title, ok := metadata["title"]; ok {
+    data["title"] = title
+  } else if title := first("article h1", "h1", "title"); title != "" {
+    data["title"] = title
+  }
+
+  if author, ok := metadata["author"]; ok {
+    data["byline"] = author
+  } else if byline := first(".byline", ".author"); byline != "" {
+    data["byline"] = byline
+  }
+
+  if published, ok := metadata["published_time"]; ok {
+    data["published_time"] = published
+  } else if published := first("article time[datetime]", "time[datetime]"); published != "" {
+    data["published_time"] = r.MetadataTime(published, page)
+  }
+
+  if image, ok := metadata["image"]; ok {
+    data["image"] = image
+  }
+
+  return data
+}
+
+func (r *SourcesRepository) ArticleContent(doc *goquery.Document) (*goquery.Selection, error) {
+  content, err := doc.Html()
+  if err != nil {
+    return nil, err
+  }
+  clone, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+  if err != nil {
+    return nil, err
+  }
+
+  clone.Find("script, style, noscript, iframe, form, nav, aside, footer, header, button, input, select, textarea, svg").Remove()
+  clone.Find("*").Each(func(i int, s *goquery.Selection) {
+    switch goquery.NodeName(s) {
+    case "html", "body", "article", "main":
+      return
+    }
+    class, _ := s.Attr("class")
+    id, _ := s.Attr("id")
+    match := class + " " + id
+    if articleUnlikely.MatchString(match) && !articleLikely.MatchString(match) {
+      s.Remove()
+    }
+  })
+
+  scores := map[*xhtml.Node]float64{}
+  var candidates []*goquery.Selection
+  clone.Find("p, pre, td, blockquote, div, section").Each(func(i int, s *goquery.Selection) {
+    switch goquery.NodeName(s) {
+    case "div", "section":
+      if s.ChildrenFiltered("p, div, section, table, ul, ol, pre, blockquote").Length() > 0 {
+        return
+      }
+    }
+    text := strings.TrimSpace(s.Text())
+    length := utf8.RuneCountInString(text)
+    if length < 25 {
+      return
+    }
+
+    score := 1 + float64(strings.Count(text, ",")+strings.Count(text, "，")+strings.Count(text, "。"))
+    score += math.Min(float64(length)/100, 3)
+
+    parent := s.Parent()
+    for level := 0; level < 2 && parent.Length() > 0; level++ {
+      node := parent.Get(0)
+      if _, ok := scores[node]; !ok {
+        scores[node] = r.ArticleWeight(parent)
+        candidates = append(candidates, parent)
+      }
+      if level == 0 {
+        scores[node] += score
+      } else {
+        scores[node] += score / 2
+      }
+      parent = parent.Parent()
+    }
+  })
+
+  var top *goquery.Selection
+  var topScore float64
+  for _, candidate := range candidates {
+    node := candidate.Get(0)
+    scores[node] *= 1 - r.LinkDensity(candidate)
+    if top == nil || scores[node] > topScore {
+      top = candidate
+      topScore = scores[node]
+    }
+  }
+  if top == nil {
+    top = clone.Find("body")
+    if top.Length() == 0 {
+      return nil, errors.New("content not exists")
+    }
+    return top, nil
+  }
+
+  threshold := math.Max(10, topScore*0.2)
+  parent := top.Parent()
+  if parent.Length() == 0 {
+    return top, nil
+  }
+  result := top
+  top.Siblings().Each(func(i int, s *goquery.Selection) {
+    if score, ok := scores[s.Get(0)]; ok && score >= threshold {
+      result = result.AddSelection(s)
       return
     }
+    if goquery.NodeName(s) == "p" {
+      text := strings.TrimSpace(s.Text())
+      if utf8.RuneCountInString(text) > 80 && r.LinkDensity(s) < 0.25 {
+        result = result.AddSelection(s)
+      }
+    }
+  })
+  if result.Length() == 1 {
+    return top, nil
+  }
+
+  wrapper, err := goquery.NewDocumentFromReader(strings.NewReader("<div></div>"))
+  if err != nil {
+    return nil, err
+  }
+  container := wrapper.Find("div")
+  parent.Children().Each(func(i int, s *goquery.Selection) {
+    if result.IsSelection(s) {
+      html, _ := goquery.OuterHtml(s)
+      container.AppendHtml(html)
+    }
+  })
+  return container, nil
+}
+
+func (r *SourcesRepository) ArticleWeight(s *goquery.Selection) float64 {
+  var weight float64
+  switch goquery.NodeName(s) {
+  case "article":
+    weight += 10
+  case "div", "section", "main":
+    weight += 5
+  case "pre", "td", "blockquote":
+    weight += 3
+  case "form", "ol", "ul", "dl", "dd", "dt", "li":
+    weight -= 3
+  case "h1", "h2", "h3", "h4", "h5", "h6", "th":
+    weight -= 5
+  }
+  class, _ := s.Attr("class")
+  id, _ := s.Attr("id")
+  for _, name := range []string{class, id} {
+    if name == "" {
+      continue
+    }
+    if articleUnlikely.MatchString(name) {
+      weight -= 25
+    }
+    if articleLikely.MatchString(name) {
+      weight += 25
+    }
+  }
+  return weight
+}
+
+func (r *SourcesRepository) LinkDensity(s *goquery.Selection) float64 {
+  length := utf8.RuneCountInString(strings.TrimSpace(s.Text()))
+  if length == 0 {
+    return 0
   }
+  var links int
+  s.Find("a").Each(func(i int, a *goquery.Selection) {
+    links += utf8.RuneCountInString(strings.TrimSpace(a.Text()))
+  })
+  return float64(links) / float64(length)
+}
 
+func (r *SourcesRepository) ArticleText(s *goquery.Selection) string {
+  var lines []string
+  s.Find("p, h1, h2, h3, h4, h5, h6, li, pre, blockquote").Each(func(i int, item *goquery.Selection) {
+    if item.ParentsFiltered("p, li, pre, blockquote").Length() > 0 {
+      return
+    }
+    text := strings.Join(strings.Fields(item.Text()), " ")
+    if text != "" {
+      lines = append(lines, text)
+    }
+  })
+  if len(lines) == 0 {
+    return strings.Join(strings.Fields(s.Text()), " ")
+  }
+  return strings.Join(lines, "\n\n")
+}
+
+func (r *SourcesRepository) ExtractMetadata(doc *goquery.Document, rules *MetadataExtractRules, page *ExtractPage) (map[string]interface{}, error) {
+  data := r.Metadata(doc, rules.Include, page)
+  if len(data) == 0 {
+    return nil, errors.New("metadata not exists")
+  }
+  return data, nil
+}
+
+func (r *SourcesRepository) Metadata(doc *goquery.Document, include []string, page *ExtractPage) map[string]interface{} {
+  opengraph := map[string]interface{}{}
+  twitter := map[string]interface{}{}
+  meta := map[string]interface{}{}
+  doc.Find("meta[content]").Each(func(i int, s *goquery.Selection) {
+    key, exists := s.Attr("property")
+    if !exists || key == "" {
+      key, _ = s.Attr("name")
+    }
+    if key == "" {
+      key, _ = s.Attr("itemprop")
+      if key == "" || s.ParentsFiltered("[itemscope]").Length() > 0 {
+        return
+      }
+    }
+    key = strings.ToLower(strings.TrimSpace(key))
+    value, _ := s.Attr("content")
+    value = strings.TrimSpace(value)
+    if value == "" {
+      return
+    }
+
+    target := meta
+    switch {
+    case strings.HasPrefix(key, "og:"):
+      target, key = opengraph, strings.TrimPrefix(key, "og:")
+    case strings.HasPrefix(key, "article:"):
+      target = opengraph
+    case strings.HasPrefix(key, "twitter:"):
+      target, key = twitter, strings.TrimPrefix(key, "twitter:")
+    }
+    if _, ok := target[key]; !ok {
+      target[key] = value
+    }
+  })
+  if href, exists := doc.Find(`link[rel="canonical"]`).First().Attr("href"); exists {
+    meta["canonical"] = strings.TrimSpace(href)
+  }
+
+  var jsonld []interface{}
+  doc.Find(`script[type="application/ld+json"]`).Each(func(i int, s *goquery.Selection) {
+    var value interface{}
+    if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &value); err != nil {
+      return
+    }
+    jsonld = append(jsonld, r.JsonLdItems(value)...)
+  })
+
+  var microdata []interface{}
+  doc.Find("[itemscope]").Each(func(i int, s *goquery.Selection) {
+    if _, exists := s.Attr("itemprop"); exists {
+      return
+    }
+    microdata = append(microdata, r.MicrodataItem(s, page))
+  })
+
+  data := map[string]interface{}{}
+  groups := map[string]interface{}{
+    "opengraph": opengraph,
+    "twitter":   twitter,
+    "meta":      meta,
+    "jsonld":    jsonld,
+    "microdata": microdata,
+  }
+  if len(include) == 0 {
+    include = []string{"opengraph", "twitter", "meta", "jsonld", "microdata"}
+  }
+  for _, name := range include {
+    if !r.SchemaEmpty(groups[name]) {
+      data[name] = groups[name]
+    }
+  }
+
+  var ld map[string]interface{}
+  for _, item := range jsonld {
+    item, _ := item.(map[string]interface{})
+    kind := r.JsonLdText(item["@type"])
+    if strings.HasSuffix(kind, "Article") || kind == "BlogPosting" || item["headline"] != nil {
+      ld = item
+      break
+    }
+    if ld == nil {
+      ld = item
+    }
+  }
+  var md map[string]interface{}
+  if len(microdata) > 0 {
+    item, _ := microdata[0].(map[string]interface{})
+    md, _ = item["properties"].(map[string]interface{})
+  }
+
+  first := func(values ...interface{}) string {
+    for _, value := range values {
+      if text := r.JsonLdText(value); text != "" {
+        return text
+      }
+    }
+    return ""
+  }
+  fields := map[string]string{
+    "type":           first(ld["@type"], opengraph["type"]),
+    "title":          first(ld["headline"], opengraph["title"], twitter["title"], md["headline"], md["name"], ld["name"]),
+    "description":    first(ld["description"], opengraph["description"], twitter["description"], meta["description"], md["description"]),
+    "url":            first(ld["url"], opengraph["url"], meta["canonical"], md["url"]),
+    "image":          first(ld["image"], opengraph["image"], twitter["image"], md["image"]),
+    "site_name":      first(opengraph["site_name"], ld["publisher"], twitter["site"]),
+    "author":         first(ld["author"], opengraph["article:author"], meta["author"], md["author"], twitter["creator"]),
+    "published_time": first(ld["datePublished"], opengraph["article:published_time"], md["datePublished"], meta["pubdate"], meta["publishdate"]),
+    "modified_time":  first(ld["dateModified"], opengraph["article:modified_time"], opengraph["updated_time"], md["dateModified"]),
+    "keywords":       first(ld["keywords"], meta["keywords"], md["keywords"]),
+    "locale":         first(ld["inLanguage"], opengraph["locale"]),
+  }
+  for key, value := range fields {
+    if value == "" {
+      continue
+    }
+    switch key {
+    case "url", "image":
+      if link, err := r.Resolve(page, value); err == nil {
+        value = link
+      }
+    case "published_time", "modified_time":
+      value = r.MetadataTime(value, page)
+    }
+    data[key] = value
+  }
+
+  return data
+}
+
+func (r *SourcesRepository) MetadataValue(s *goquery.Selection, path string, page *ExtractPage) (interface{}, bool) {
+  if path == "" || len(s.Nodes) == 0 {
+    return nil, false
+  }
+
+  var content []byte
+  if page != nil {
+    content = page.Metadata
+  }
+  if content == nil {
+    root := s.Nodes[0]
+    for root.Parent != nil {
+      root = root.Parent
+    }
+    content, _ = json.Marshal(r.Metadata(goquery.NewDocumentFromNode(root), nil, page))
+    if page != nil {
+      page.Metadata = content
+    }
+  }
+
+  result := gjson.GetBytes(content, path)
+  if !result.Exists() || r.SchemaEmpty(result.Value()) {
+    return nil, false
+  }
+  return result.Value(), true
+}
+
+func (r *SourcesRepository) MetadataTime(text string, page *ExtractPage) string {
+  datetime, err := common.ParseDatetime(text, page.Time, page.Location)
+  if err != nil {
+    return text
+  }
+  return datetime.Format(time.RFC3339)
+}
+
+func (r *SourcesRepository) JsonLdItems(value interface{}) (items []interface{}) {
+  switch value := value.(type) {
+  case []interface{}:
+    for _, item := range value {
+      items = append(items, r.JsonLdItems(item)...)
+    }
+  case map[string]interface{}:
+    if graph, ok := value["@graph"]; ok {
+      return r.JsonLdItems(graph)
+    }
+    items = append(items, value)
+  }
+  return
+}
+
+func (r *SourcesRepository) JsonLdText(value interface{}) string {
+  switch value := value.(type) {
+  case string:
+    return strings.TrimSpace(value)
+  case float64:
+    return strconv.FormatFloat(value, 'f', -1, 64)
+  case []interface{}:
+    var texts []string
+    for _, item := range value {
+      if text := r.JsonLdText(item); text != "" {
+        texts = append(texts, text)
+      }
+    }
+    if len(texts) > 0 {
+      if _, ok := value[0].(string); ok {
+        return strings.Join(texts, ",")
+      }
+      return texts[0]
+    }
+  case map[string]interface{}:
+    for _, key := range []string{"name", "url", "@id"} {
+      if text := r.JsonLdText(value[key]); text != "" {
+        return text
+      }
+    }
+    if properties, ok := value["properties"]; ok {
+      return r.JsonLdText(properties)
+    }
+  }
+  return ""
+}
+
+func (r *SourcesRepository) MicrodataItem(s *goquery.Selection, page *ExtractPage) map[string]interface{} {
+  item := map[string]interface{}{}
+  if kind, exists := s.Attr("itemtype"); exists {
+    item["type"] = strings.TrimSpace(kind)
+  }
+  properties := map[string]interface{}{}
+  s.Find("[itemprop]").Each(func(i int, prop *goquery.Selection) {
+    if !prop.ParentsFiltered("[itemscope]").First().IsSelection(s) {
+      return
+    }
+    var value interface{}
+    if _, exists := prop.Attr("itemscope"); exists {
+      value = r.MicrodataItem(prop, page)
+    } else {
+      value = r.MicrodataValue(prop, page)
+    }
+    for _, name := range strings.Fields(prop.AttrOr("itemprop", "")) {
+      switch current := properties[name].(type) {
+      case nil:
+        properties[name] = value
+      case []interface{}:
+        properties[name] = append(current, value)
+      default:
+        properties[name] = []interface{}{current, value}
+      }
+    }
+  })
+  item["properties"] = properties
+  return item
+}
+
+func (r *SourcesRepository) MicrodataValue(s *goquery.Selection, page *ExtractPage) string {
+  var value string
+  switch goquery.NodeName(s) {
+  case "meta":
+    value = s.AttrOr("content", "")
+  case "a", "link", "area":
+    value = s.AttrOr("href", "")
+    if link, err := r.Resolve(page, value); err == nil {
+      value = link
+    }
+  case "img", "audio", "video", "source", "iframe", "embed", "track":
+    value = s.AttrOr("src", "")
+    if link, err := r.Resolve(page, value); err == nil {
+      value = link
+    }
+  case "time":
+    value = s.AttrOr("datetime", s.Text())
+  case "data", "meter":
+    value = s.AttrOr("value", "")
+  default:
+    value = s.AttrOr("content", s.Text())
+  }
+  return strings.Join(strings.Fields(value), " ")
+}
+
+func (r *SourcesRepository) ScriptJson(script string, variable string) (string, error) {
+  script = strings.TrimSpace(script)
+  if variable != "" {
+    m, err := r.Regexp(variable + `\s*=\s*`)
+    if err != nil {
+      return "", err
+    }
+    loc := m.FindStringIndex(script)
+    if loc == nil {
+      return "", errors.New("variable not exists")
+    }
+    script = script[loc[1]:]
+  } else if !strings.HasPrefix(script, "{") && !strings.HasPrefix(script, "[") {
+    loc := scriptCallback.FindStringIndex(script)
+    if loc == nil {
+      return "", errors.New("json not exists")
+    }
+    script = script[loc[1]:]
+  }
+
+  start := strings.IndexAny(script, "{[")
+  if start < 0 {
+    return "", errors.New("json not exists")
+  }
+  var raw json.RawMessage
+  err := json.NewDecoder(strings.NewReader(script[start:])).Decode(&raw)
+  if err != nil {
+    return "", err
+  }
+  return string(raw), nil
+}
+
+func (r *SourcesRepository) XmlPreset(rules *XmlExtractRules) *XmlExtractRules {
+  var preset *XmlExtractRules
+  switch rules.Preset {
+  case "rss":
+    preset = &XmlExtractRules{
+      Namespaces: map[string]string{
+        "content": "http://purl.org/rss/1.0/modules/content/",
+      },
+      List: "//channel/item",
+      Fields: []*XmlExtractField{
+        {Name: "title", Path: "title"},
+        {Name: "link", Path: "link"},
+        {Name: "guid", Path: "guid"},
+        {Name: "pubDate", Path: "pubDate"},
+        {Name: "content", Path: "content:encoded | description[not(../content:encoded)]"},
+      },
+    }
+  case "atom":
+    preset = &XmlExtractRules{
+      List: "//entry",
+      Fields: []*XmlExtractField{
+        {Name: "title", Path: "title"},
+        {Name: "link", Path: "link[@rel='alternate' or not(@rel)]/@href"},
+        {Name: "guid", Path: "id"},
+        {Name: "pubDate", Path: "published | updated[not(../published)]"},
+        {Name: "content", Path: "content | summary[not(../content)]"},
+      },
+    }
+  default:
+    return rules
+  }
+
+  if len(rules.Namespaces) > 0 {
+    namespaces := map[string]string{}
+    for prefix, url := range preset.Namespaces {
+      namespaces[prefix] = url
+    }
+    for prefix, url := range rules.Namespaces {
+      namespaces[prefix] = url
+    }
+    preset.Namespaces = namespaces
+  } else {
+    preset.Namespaces = nil
+  }
+  if rules.Container != "" {
+    preset.Container = rules.Container
+  }
+  if rules.List != "" {
+    preset.List = rules.List
+  }
+  preset.Filters = rules.Filters
+  for _, field := range rules.Fields {
+    exists := false
+    for i, item := range preset.Fields {
+      if item.Name == field.Name {
+        preset.Fields[i] = field
+        exists = true
+      }
+    }
+    if !exists {
+      preset.Fields = append(preset.Fields, field)
+    }
+  }
+
+  return preset
+}
+
+func (r *SourcesRepository) ExtractXml(doc *xmlquery.Node, rules *XmlExtractRules, page *ExtractPage) (map[string]interface{}, error) {
+  rules = r.XmlPreset(rules)
+
+  container := doc
+  if rules.Container != "" {
+    nodes, err := r.XmlQuery(doc, rules.Container, rules.Namespaces)
+    if err != nil {
+      return nil, err
+    }
+    if len(nodes) == 0 {
+      return nil, errors.New("container not exists")
+    }
+    container = nodes[0]
+  }
+
+  return r.ExtractXmlFields(container, rules.Fields, rules.Namespaces, page)
+}
+
+func (r *SourcesRepository) ExtractXmlList(doc *xmlquery.Node, rules *XmlExtractRules, page *ExtractPage) (result []map[string]interface{}, err error) {
+  rules = r.XmlPreset(rules)
+
+  container := doc
+  if rules.Container != "" {
+    var nodes []*xmlquery.Node
+    nodes, err = r.XmlQuery(doc, rules.Container, rules.Namespaces)
+    if err != nil {
+      return
+    }
+    if len(nodes) == 0 {
+      err = errors.New("container not exists")
+      return
+    }
+    container = nodes[0]
+  }
+
+  items, err := r.XmlQuery(container, rules.List, rules.Namespaces)
+  if err != nil {
+    return
+  }
+  for _, item := range items {
+    data, err := r.ExtractXmlFields(item, rules.Fields, rules.Namespaces, page)
+    if err != nil || !r.Filter(data, rules.Filters) {
+      continue
+    }
+    result = append(result, data)
+  }
+
+  return
+}
+
+func (r *SourcesRepository) ExtractXmlFields(n *xmlquery.Node, fields []*XmlExtractField, namespaces map[string]string, page *ExtractPage) (data map[string]interface{}, err error) {
+  data = make(map[string]interface{})
+  for _, field := range fields {
+    var nodes []*xmlquery.Node
+    nodes, err = r.XmlQuery(n, field.Path, namespaces)
+    if err != nil {
+      return
+    }
+    if len(nodes) == 0 {
+      if value, ok := r.MissingValue(field.Transforms, page); ok {
+        data[field.Name] = value
+      }
+      continue
+    }
+    data[field.Name] = strings.TrimSpace(nodes[0].InnerText())
+
+    if len(field.Fields) > 0 {
+      if len(nodes) > 1 {
+        var result []map[string]interface{}
+        for _, node := range nodes {
+          item, err := r.ExtractXmlFields(node, field.Fields, namespaces, page)
+          if err != nil {
+            continue
+          }
+          result = append(result, item)
+        }
+        data[field.Name] = result
+      } else {
+        result, err := r.ExtractXmlFields(nodes[0], field.Fields, namespaces, page)
+        if err != nil {
+          continue
+        }
+        data[field.Name] = result
+      }
+    }
+
+    data[field.Name] = r.Replace(data[field.Name], field.RegexReplace, field.TextReplace)
+
+    if field.RegexExtract != nil {
+      var matched bool
+      data[field.Name], matched, err = r.RegexExtract(data[field.Name], field.RegexExtract)
+      if err != nil {
+        return
+      }
+      if !matched {
+        switch field.RegexExtract.Miss {
+        case "null":
+          data[field.Name] = nil
+          continue
+        case "skip":
+          err = errors.New("field not extracted")
+          return
+        }
+        delete(data, field.Name)
+        continue
+      }
+    }
+
+    if field.Match != "" && field.Match != data[field.Name] {
+      err = errors.New("field not match")
+      return
+    }
+
+    if len(field.Transforms) > 0 {
+      value, err := r.Transform(data[field.Name], field.Transforms, page)
+      if err != nil {
+        delete(data, field.Name)
+        continue
+      }
+      data[field.Name] = value
+    }
+  }
+
+  return
+}
+
+func (r *SourcesRepository) XmlQuery(n *xmlquery.Node, path string, namespaces map[string]string) ([]*xmlquery.Node, error) {
+  expr, err := r.Xpath(path, namespaces)
+  if err != nil {
+    return nil, err
+  }
+  return xmlquery.QuerySelectorAll(n, expr), nil
+}
+
+func (r *SourcesRepository) Xpath(path string, namespaces map[string]string) (*xpath.Expr, error) {
+  key := path
+  if len(namespaces) > 0 {
+    key = fmt.Sprintf("%s %v", path, namespaces)
+  }
+  if expr, ok := xpathCache.Load(key); ok {
+    return expr.(*xpath.Expr), nil
+  }
+
+  var expr *xpath.Expr
+  var err error
+  if len(namespaces) > 0 {
+    expr, err = xpath.CompileWithNS(path, namespaces)
+  } else {
+    expr, err = xpath.Compile(path)
+  }
+  if err != nil {
+    return nil, err
+  }
+  xpathCache.Store(key, expr)
+  return expr, nil
+}
+
+func (r *SourcesRepository) Regexp(pattern string) (*regexp.Regexp, error) {
+  if m, ok := regexpCache.Load(pattern); ok {
+    return m.(*regexp.Regexp), nil
+  }
+  m, err := regexp.Compile(pattern)
+  if err != nil {
+    return nil, err
+  }
+  regexpCache.Store(pattern, m)
+  return m, nil
+}
+
+func (r *SourcesRepository) RegexExtract(value interface{}, extract *RegexExtract) (interface{}, bool, error) {
+  if items, ok := value.([]interface{}); ok {
+    var result []interface{}
+    for _, item := range items {
+      item, matched, err := r.RegexExtract(item, extract)
+      if err != nil {
+        return nil, false, err
+      }
+      if matched {
+        result = append(result, item)
+      }
+    }
+    return result, len(result) > 0, nil
+  }
+
+  m, err := r.Regexp(extract.Pattern)
+  if err != nil {
+    return nil, false, err
+  }
+
+  group := 0
+  if extract.Group != "" {
+    group, err = strconv.Atoi(extract.Group)
+    if err != nil {
+      group = m.SubexpIndex(extract.Group)
+    }
+  } else if m.NumSubexp() > 0 {
+    group = 1
+  }
+  if group < 0 || group > m.NumSubexp() {
+    return nil, false, nil
+  }
+
+  var text string
+  if value != nil {
+    text = fmt.Sprintf("%v", value)
+  }
+
+  if !extract.All {
+    matches := m.FindStringSubmatch(text)
+    if matches == nil {
+      return nil, false, nil
+    }
+    return matches[group], true, nil
+  }
+
+  var result []interface{}
+  for _, matches := range m.FindAllStringSubmatch(text, -1) {
+    result = append(result, matches[group])
+  }
+  if len(result) == 0 {
+    return nil, false, nil
+  }
+  return result, true, nil
+}
+
+func (r *SourcesRepository) Transform(value interface{}, transforms []*Transform, page *ExtractPage) (interface{}, error) {
+  var err error
+  for _, transform := range transforms {
+    value, err = r.TransformValue(value, transform, page)
+    if err != nil {
+      return nil, err
+    }
+  }
+  return value, nil
+}
+
+func (r *SourcesRepository) MissingValue(transforms []*Transform, page *ExtractPage) (interface{}, bool) {
+  for i, transform := range transforms {
+    if transform.Type != "default" {
+      continue
+    }
+    value, err := r.Transform(nil, transforms[i:], page)
+    if err != nil {
+      return nil, false
+    }
+    return value, true
+  }
+  return nil, false
+}
+
+func (r *SourcesRepository) TransformValue(value interface{}, transform *Transform, page *ExtractPage) (interface{}, error) {
+  if items, ok := value.([]interface{}); ok && transform.Type != "default" {
+    var result []interface{}
+    for _, item := range items {
+      item, err := r.TransformValue(item, transform, page)
+      if err != nil {
+        return nil, err
+      }
+      result = append(result, item)
+    }
+    return result, nil
+  }
+
+  var text string
+  if value != nil {
+    text = fmt.Sprintf("%v", value)
+  }
+
+  switch transform.Type {
+  case "trim":
+    return strings.Join(strings.Fields(text), " "), nil
+  case "unescape":
+    return html.UnescapeString(text), nil
+  case "lower":
+    return strings.ToLower(text), nil
+  case "int":
+    if number, ok := value.(float64); ok {
+      return int64(number), nil
+    }
+    return strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+  case "float":
+    return strconv.ParseFloat(strings.TrimSpace(text), 64)
+  case "bool":
+    return strconv.ParseBool(strings.TrimSpace(text))
+  case "date":
+    layout := transform.Layout
+    if layout == "" {
+      layout = "2006-01-02 15:04:05"
+    }
+    location := time.Local
+    if transform.Timezone != "" {
+      var err error
+      location, err = time.LoadLocation(transform.Timezone)
+      if err != nil {
+        return nil, err
+      }
+    }
+    return r.TransformDate(text, layout, location)
+  case "datetime":
+    location := time.Local
+    if page != nil && page.Location != nil {
+      location = page.Location
+    }
+    if transform.Timezone != "" {
+      var err error
+      location, err = time.LoadLocation(transform.Timezone)
+      if err != nil {
+        return nil, err
+      }
+    }
+    now := time.Now()
+    if page != nil && !page.Time.IsZero() {
+      now = page.Time
+    }
+    if number, ok := value.(float64); ok {
+      text = strconv.FormatFloat(number, 'f', -1, 64)
+    }
+    datetime, err := common.ParseDatetime(text, now, location)
+    if err != nil {
+      return nil, err
+    }
+    return datetime.Format(time.RFC3339), nil
+  case "url":
+    if transform.Value == "" {
+      return r.Resolve(page, text)
+    }
+    base, err := url.Parse(transform.Value)
+    if err != nil {
+      return nil, err
+    }
+    return r.Resolve(&ExtractPage{BaseUrl: base}, text)
+  case "split":
+    var result []interface{}
+    for _, item := range strings.Split(text, transform.Separator) {
+      item = strings.TrimSpace(item)
+      if item == "" {
+        continue
+      }
+      result = append(result, item)
+    }
+    return result, nil
+  case "default":
+    if value == nil || text == "" {
+      return transform.Value, nil
+    }
+    if items, ok := value.([]interface{}); ok && len(items) == 0 {
+      return transform.Value, nil
+    }
+    return value, nil
+  }
+
+  return nil, errors.New(fmt.Sprintf("transform %s not supported", transform.Type))
+}
+
+func (r *SourcesRepository) Validate(value interface{}, schema *ExtractSchema) (valid interface{}, rejected []interface{}) {
+  if items, ok := value.([]map[string]interface{}); ok {
+    var values []interface{}
+    for _, item := range items {
+      values = append(values, item)
+    }
+    value = values
+  }
+
+  if items, ok := value.([]interface{}); ok {
+    result := []interface{}{}
+    for _, item := range items {
+      if err := r.ValidateItem(item, schema); err != nil {
+        rejected = append(rejected, map[string]interface{}{
+          "item":  item,
+          "error": err.Error(),
+        })
+        continue
+      }
+      result = append(result, item)
+    }
+    return result, rejected
+  }
+
+  if err := r.ValidateItem(value, schema); err != nil {
+    rejected = append(rejected, map[string]interface{}{
+      "item":  value,
+      "error": err.Error(),
+    })
+    return nil, rejected
+  }
+  return value, nil
+}
+
+func (r *SourcesRepository) ValidateItem(value interface{}, schema *ExtractSchema) error {
+  item, ok := value.(map[string]interface{})
+  if !ok {
+    return errors.New("item is not an object")
+  }
+  for _, field := range schema.Fields {
+    if r.SchemaEmpty(item[field.Name]) {
+      if field.Default != "" {
+        value, err := r.SchemaDefault(field)
+        if err != nil {
+          return err
+        }
+        item[field.Name] = value
+        continue
+      }
+      if field.Required {
+        return errors.New(fmt.Sprintf("field %s is required", field.Name))
+      }
+      continue
+    }
+    if !r.SchemaType(item[field.Name], field.Type) {
+      return errors.New(fmt.Sprintf("field %s is not %s", field.Name, field.Type))
+    }
+  }
+  return nil
+}
+
+func (r *SourcesRepository) SchemaEmpty(value interface{}) bool {
+  switch value := value.(type) {
+  case nil:
+    return true
+  case string:
+    return value == ""
+  case []interface{}:
+    return len(value) == 0
+  case []map[string]interface{}:
+    return len(value) == 0
+  case map[string]interface{}:
+    return len(value) == 0
+  }
+  return false
+}
+
+func (r *SourcesRepository) SchemaDefault(field *SchemaField) (interface{}, error) {
+  switch field.Type {
+  case "int", "float", "bool":
+    return r.TransformValue(field.Default, &Transform{Type: field.Type}, nil)
+  case "array", "object":
+    var value interface{}
+    err := json.Unmarshal([]byte(field.Default), &value)
+    return value, err
+  }
+  return field.Default, nil
+}
+
+func (r *SourcesRepository) SchemaType(value interface{}, kind string) bool {
+  switch kind {
+  case "":
+    return true
+  case "string":
+    _, ok := value.(string)
+    return ok
+  case "int":
+    switch value := value.(type) {
+    case int, int64:
+      return true
+    case float64:
+      return value == float64(int64(value))
+    }
+    return false
+  case "float":
+    switch value.(type) {
+    case int, int64, float64:
+      return true
+    }
+    return false
+  case "bool":
+    _, ok := value.(bool)
+    return ok
+  case "array":
+    switch value.(type) {
+    case []interface{}, []map[string]interface{}:
+      return true
+    }
+    return false
+  case "object":
+    _, ok := value.(map[string]interface{})
+    return ok
+  }
+  return false
+}
+
+func (r *SourcesRepository) Location(source *models.Source) *time.Location {
+  timezone, _ := source.Params["timezone"].(string)
+  if timezone == "" {
+    return time.Local
+  }
+  location, err := time.LoadLocation(timezone)
+  if err != nil {
+    return time.Local
+  }
+  return location
+}
+
+func (r *SourcesRepository) TransformDate(text string, layout string, location *time.Location) (string, error) {
+  datetime, err := time.ParseInLocation(layout, strings.TrimSpace(text), location)
+  if err != nil {
+    return "", err
+  }
+  return datetime.Format(time.RFC3339), nil
+}
+
+func (r *SourcesRepository) Rules(source *models.Source) (map[string]*ExtractRules, error) {
+  if cached, ok := rulesCache.Load(source.ID); ok {
+    cached := cached.(*CompiledRules)
+    if cached.UpdatedAt.Equal(source.UpdatedAt) {
+      return cached.Rules, nil
+    }
+  }
+
+  rules := map[string]*ExtractRules{}
+  var errs []string
+  for name, value := range source.ExtractRules {
+    rules[name] = r.ToExtractRules(value)
+    errs = append(errs, r.CompileRules(fmt.Sprintf("extract_rules.%s", name), rules[name])...)
+  }
+  if _, err := r.Order(rules); err != nil {
+    errs = append(errs, err.Error())
+  }
+  if len(errs) > 0 {
+    return nil, errors.New(strings.Join(errs, "; "))
+  }
+
+  rulesCache.Store(source.ID, &CompiledRules{
+    UpdatedAt: source.UpdatedAt,
+    Rules:     rules,
+  })
+  return rules, nil
+}
+
+func (r *SourcesRepository) Version(source *models.Source) string {
+  hash := sha1.New()
+  hash.Write([]byte(source.Method))
+  hash.Write([]byte(source.Body))
+  hash.Write(r.Content(source.Params))
+  hash.Write(r.Content(source.ExtractRules))
+  return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (r *SourcesRepository) ValidateRequest(method string, body string) error {
+  switch method {
+  case "", http.MethodGet, http.MethodHead:
+    if body != "" {
+      return errors.New(fmt.Sprintf("body: method %s not allow body", method))
+    }
+  case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+  default:
+    return errors.New(fmt.Sprintf("method: method %s not supported", method))
+  }
+  return nil
+}
+
+func (r *SourcesRepository) ValidateRules(params map[string]interface{}, extractRules map[string]*ExtractRules) error {
+  var names []string
+  for name := range extractRules {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+
+  var errs []string
+  for _, name := range names {
+    errs = append(errs, r.CompileRules(fmt.Sprintf("extract_rules.%s", name), extractRules[name])...)
+  }
+  if _, err := r.Order(extractRules); err != nil {
+    errs = append(errs, err.Error())
+  }
+  errs = append(errs, r.ValidateParams(r.JSONMap(params), extractRules)...)
+  if len(errs) > 0 {
+    return errors.New(strings.Join(errs, "; "))
+  }
+  return nil
+}
+
+func (r *SourcesRepository) Order(extractRules map[string]*ExtractRules) ([]string, error) {
+  var names []string
+  for name := range extractRules {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+
+  degrees := map[string]int{}
+  children := map[string][]string{}
+  for _, name := range names {
+    input := extractRules[name].Input
+    if input == "" {
+      continue
+    }
+    if _, ok := extractRules[input]; !ok {
+      return nil, errors.New(fmt.Sprintf("extract_rules.%s.input: rule %s not exists", name, input))
+    }
+    degrees[name]++
+    children[input] = append(children[input], name)
+  }
+
+  var queue []string
+  for _, name := range names {
+    if degrees[name] == 0 {
+      queue = append(queue, name)
+    }
+  }
+
+  var order []string
+  for len(queue) > 0 {
+    name := queue[0]
+    queue = queue[1:]
+    order = append(order, name)
+    for _, child := range children[name] {
+      degrees[child]--
+      if degrees[child] == 0 {
+        queue = append(queue, child)
+      }
+    }
+  }
+
+  if len(order) < len(names) {
+    var cycle []string
+    for _, name := range names {
+      if degrees[name] > 0 {
+        cycle = append(cycle, name)
+      }
+    }
+    return nil, errors.New(fmt.Sprintf("extract_rules: cycle between rules %s", strings.Join(cycle, ", ")))
+  }
+
+  return order, nil
+}
+
+func (r *SourcesRepository) Content(value interface{}) []byte {
+  if content, ok := value.(string); ok {
+    return []byte(content)
+  }
+  content, _ := json.Marshal(value)
+  return content
+}
+
+func (r *SourcesRepository) ValidateParams(params map[string]interface{}, extractRules map[string]*ExtractRules) (errs []string) {
+  split, _ := params["split"].(map[string]interface{})
+  var parents []string
+  for parent := range split {
+    parents = append(parents, parent)
+  }
+  sort.Strings(parents)
+  for _, parent := range parents {
+    source, err := r.GetBySlug(parent)
+    if err != nil {
+      errs = append(errs, fmt.Sprintf("params.split.%s: source not exists", parent))
+      continue
+    }
+    paths, _ := split[parent].([]interface{})
+    for i, path := range paths {
+      path, _ := path.(string)
+      name := strings.SplitN(path, ".", 2)[0]
+      rules, ok := source.ExtractRules[name]
+      if !ok {
+        errs = append(errs, fmt.Sprintf("params.split.%s[%d]: rule %s not exists", parent, i, name))
+      } else if rules := r.ToExtractRules(rules); rules != nil && rules.Internal {
+        errs = append(errs, fmt.Sprintf("params.split.%s[%d]: rule %s is internal", parent, i, name))
+      }
+    }
+  }
+
+  if label, _ := params["charset"].(string); label != "" {
+    if e, _ := charset.Lookup(label); e == nil {
+      errs = append(errs, fmt.Sprintf("params.charset: charset %s not supported", label))
+    }
+  }
+
+  if proxy, ok := params["proxy"].(map[string]interface{}); ok {
+    pool, _ := proxy["pool"].(string)
+    tag, _ := proxy["tag"].(string)
+    if pool == "" && tag == "" {
+      errs = append(errs, "params.proxy: pool or tag is required")
+    }
+    switch proxy["rotation"] {
+    case nil, "", "round_robin", "random", "sticky":
+    default:
+      errs = append(errs, fmt.Sprintf("params.proxy.rotation: rotation %v not supported", proxy["rotation"]))
+    }
+  }
+
+  if value, ok := params["robots"]; ok {
+    if _, ok := value.(bool); !ok {
+      errs = append(errs, fmt.Sprintf("params.robots: %v is invalid", value))
+    }
+  }
+
+  if limit, ok := params["limit"].(map[string]interface{}); ok {
+    for _, key := range []string{"rate", "concurrency", "jitter"} {
+      value, ok := limit[key]
+      if !ok {
+        continue
+      }
+      if number, ok := value.(float64); !ok || number < 0 {
+        errs = append(errs, fmt.Sprintf("params.limit.%s: %v is invalid", key, value))
+      }
+    }
+  }
+
+  if timezone, _ := params["timezone"].(string); timezone != "" {
+    if _, err := time.LoadLocation(timezone); err != nil {
+      errs = append(errs, fmt.Sprintf("params.timezone: %v", err))
+    }
+  }
+
+  scroll, _ := params["scroll"].(string)
+  if scroll != "" {
+    name := strings.SplitN(scroll, ".", 2)[0]
+    if rules, ok := extractRules[name]; !ok {
+      errs = append(errs, fmt.Sprintf("params.scroll: rule %s not exists", name))
+    } else if rules.Internal {
+      errs = append(errs, fmt.Sprintf("params.scroll: rule %s is internal", name))
+    }
+  }
+
+  query, _ := params["query"].([]interface{})
+  for i, item := range query {
+    item, _ := item.(map[string]interface{})
+    if name, _ := item["name"].(string); name == "" {
+      errs = append(errs, fmt.Sprintf("params.query[%d].name: name is required", i))
+    }
+    if value, _ := item["value"].(string); strings.Contains(value, "{{") {
+      if _, err := r.ParseTemplate(value); err != nil {
+        errs = append(errs, fmt.Sprintf("params.query[%d].value: %v", i, err))
+      }
+    }
+    switch item["value"] {
+    case "$0":
+      if len(split) == 0 {
+        errs = append(errs, fmt.Sprintf("params.query[%d].value: $0 requires split", i))
+      }
+    case "$1":
+      if scroll == "" {
+        errs = append(errs, fmt.Sprintf("params.query[%d].value: $1 requires scroll", i))
+      }
+    }
+  }
+
+  return
+}
+
+func (r *SourcesRepository) CompileRules(path string, rules *ExtractRules) (errs []string) {
+  if rules == nil || rules.Html == nil && rules.Json == nil && rules.Script == nil && rules.Xml == nil && rules.Article == nil && rules.Metadata == nil {
+    return []string{fmt.Sprintf("%s: rules is empty", path)}
+  }
+  var kinds []string
+  for kind, set := range map[string]bool{
+    "html":     rules.Html != nil,
+    "json":     rules.Json != nil,
+    "script":   rules.Script != nil,
+    "xml":      rules.Xml != nil,
+    "article":  rules.Article != nil,
+    "metadata": rules.Metadata != nil,
+  } {
+    if set {
+      kinds = append(kinds, kind)
+    }
+  }
+  if len(kinds) > 1 {
+    sort.Strings(kinds)
+    errs = append(errs, fmt.Sprintf("%s: only one rule type allowed, got %s", path, strings.Join(kinds, ", ")))
+  }
+  if rules.Html != nil {
+    errs = append(errs, r.CompileHtml(path+".html", rules.Html)...)
+  }
+  if rules.Json != nil {
+    errs = append(errs, r.CompileJson(path+".json", rules.Json)...)
+  }
+  if rules.Script != nil {
+    errs = append(errs, r.CompileScript(path+".script", rules.Script)...)
+  }
+  if rules.Xml != nil {
+    errs = append(errs, r.CompileXml(path+".xml", rules.Xml)...)
+  }
+  if rules.Article != nil {
+    errs = append(errs, r.CompileArticle(path+".article", rules.Article)...)
+  }
+  if rules.Metadata != nil {
+    for i, name := range rules.Metadata.Include {
+      switch name {
+      case "opengraph", "twitter", "meta", "jsonld", "microdata":
+      default:
+        errs = append(errs, fmt.Sprintf("%s.metadata.include[%d]: metadata %s not supported", path, i, name))
+      }
+    }
+  }
+  if rules.Schema != nil {
+    errs = append(errs, r.CompileSchema(path+".schema", rules.Schema)...)
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileHtml(path string, rules *HtmlExtractRules) (errs []string) {
+  if rules.Container == nil || rules.Container.Selector == "" {
+    errs = append(errs, fmt.Sprintf("%s.container: selector is required", path))
+  } else {
+    errs = append(errs, r.CompileNode(path+".container", rules.Container)...)
+  }
+  if rules.List != nil {
+    if rules.List.Selector == "" {
+      errs = append(errs, fmt.Sprintf("%s.list: selector is required", path))
+    }
+    errs = append(errs, r.CompileNode(path+".list", rules.List)...)
+  }
+  errs = append(errs, r.CompileHtmlFields(path+".fields", rules.Fields)...)
+  errs = append(errs, r.CompileComputed(path+".computed", rules.Computed)...)
+  errs = append(errs, r.CompileFilters(path+".filters", rules.Filters)...)
+  return
+}
+
+func (r *SourcesRepository) CompileNode(path string, node *HtmlExtractNode) (errs []string) {
+  switch node.Type {
+  case "", "xpath":
+  default:
+    return []string{fmt.Sprintf("%s.type: type %s not supported", path, node.Type)}
+  }
+  if node.Selector != "" {
+    if err := r.CompileSelector(node.Selector, node.Type); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.selector: %v", path, err))
+    }
+  }
+  for i, selector := range node.Fallbacks {
+    if err := r.CompileSelector(selector, node.Type); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.fallbacks[%d]: %v", path, i, err))
+    }
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileSelector(selector string, kind string) error {
+  if kind == "xpath" {
+    _, err := xpath.Compile(selector)
+    return err
+  }
+  _, err := cascadia.Compile(selector)
+  return err
+}
+
+func (r *SourcesRepository) CompileHtmlFields(path string, fields []*HtmlExtractField) (errs []string) {
+  for i, field := range fields {
+    path := fmt.Sprintf("%s[%d]", path, i)
+    if field.Name == "" {
+      errs = append(errs, fmt.Sprintf("%s.name: name is required", path))
+    }
+    if field.Node == nil {
+      errs = append(errs, fmt.Sprintf("%s.node: node is required", path))
+    } else {
+      errs = append(errs, r.CompileNode(path+".node", field.Node)...)
+      if field.Match != "" && field.Node.All {
+        errs = append(errs, fmt.Sprintf("%s.match: match not supported with node.all", path))
+      }
+    }
+    switch field.Output {
+    case "", "text", "html", "outer_html", "sanitized_html", "markdown":
+    default:
+      errs = append(errs, fmt.Sprintf("%s.output: output %s not supported", path, field.Output))
+    }
+    errs = append(errs, r.CompileSteps(path, field.RegexReplace, field.RegexExtract, field.Transforms)...)
+    errs = append(errs, r.CompileHtmlFields(path+".fields", field.Fields)...)
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileJson(path string, rules *JsonExtractRules) (errs []string) {
+  if err := r.JsonPath(rules.Container); err != nil {
+    errs = append(errs, fmt.Sprintf("%s.node: %v", path, err))
+  }
+  errs = append(errs, r.CompileJsonFallbacks(path+".container_fallbacks", rules.Container, rules.ContainerFallbacks)...)
+  if err := r.JsonPath(rules.List); err != nil {
+    errs = append(errs, fmt.Sprintf("%s.list: %v", path, err))
+  }
+  errs = append(errs, r.CompileJsonFallbacks(path+".list_fallbacks", rules.List, rules.ListFallbacks)...)
+  errs = append(errs, r.CompileJsonFields(path+".fields", rules.Fields)...)
+  errs = append(errs, r.CompileComputed(path+".computed", rules.Computed)...)
+  errs = append(errs, r.CompileFilters(path+".filters", rules.Filters)...)
+  return
+}
+
+func (r *SourcesRepository) CompileJsonFallbacks(path string, primary string, fallbacks []string) (errs []string) {
+  if primary == "" && len(fallbacks) > 0 {
+    errs = append(errs, fmt.Sprintf("%s: fallbacks require a primary path", path))
+  }
+  for i, fallback := range fallbacks {
+    if err := r.JsonPath(fallback); err != nil {
+      errs = append(errs, fmt.Sprintf("%s[%d]: %v", path, i, err))
+    }
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileJsonFields(path string, fields []*JsonExtractField) (errs []string) {
+  for i, field := range fields {
+    path := fmt.Sprintf("%s[%d]", path, i)
+    if field.Name == "" {
+      errs = append(errs, fmt.Sprintf("%s.name: name is required", path))
+    }
+    if field.Path == "" {
+      errs = append(errs, fmt.Sprintf("%s.path: path is required", path))
+    } else if err := r.JsonPath(field.Path); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.path: %v", path, err))
+    }
+    for i, fallback := range field.Fallbacks {
+      if err := r.JsonPath(fallback); err != nil {
+        errs = append(errs, fmt.Sprintf("%s.fallbacks[%d]: %v", path, i, err))
+      }
+    }
+    errs = append(errs, r.CompileSteps(path, field.RegexReplace, field.RegexExtract, field.Transforms)...)
+    errs = append(errs, r.CompileJsonFields(path+".fields", field.Fields)...)
+  }
+  return
+}
+
+func (r *SourcesRepository) JsonPath(path string) error {
+  var stack []rune
+  closers := map[rune]rune{']': '[', ')': '(', '}': '{'}
+  escaped := false
+  var quote rune
+  for _, c := range path {
+    switch {
+    case escaped:
+      escaped = false
+    case c == '\\':
+      escaped = true
+    case quote != 0:
+      if c == quote {
+        quote = 0
+      }
+    case c == '"':
+      quote = c
+    case c == '[' || c == '(' || c == '{':
+      stack = append(stack, c)
+    case c == ']' || c == ')' || c == '}':
+      if len(stack) == 0 || stack[len(stack)-1] != closers[c] {
+        return errors.New(fmt.Sprintf("unexpected %c in path", c))
+      }
+      stack = stack[:len(stack)-1]
+    }
+  }
+  if quote != 0 || len(stack) > 0 {
+    return errors.New("unterminated path")
+  }
+  return nil
+}
+
+func (r *SourcesRepository) CompileScript(path string, rules *ScriptExtractRules) (errs []string) {
+  if rules.Node != nil {
+    errs = append(errs, r.CompileNode(path+".node", rules.Node)...)
+  }
+  if rules.Variable != "" {
+    if _, err := r.Regexp(rules.Variable + `\s*=\s*`); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.variable: %v", path, err))
+    }
+  }
+  if rules.Json == nil {
+    errs = append(errs, fmt.Sprintf("%s.json: json is required", path))
+  } else {
+    errs = append(errs, r.CompileJson(path+".json", rules.Json)...)
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileArticle(path string, rules *ArticleExtractRules) (errs []string) {
+  switch rules.Output {
+  case "", "text", "html", "sanitized_html", "markdown":
+  default:
+    errs = append(errs, fmt.Sprintf("%s.output: output %s not supported", path, rules.Output))
+  }
+  errs = append(errs, r.CompileHtmlFields(path+".fields", rules.Fields)...)
+  errs = append(errs, r.CompileComputed(path+".computed", rules.Computed)...)
+  return
+}
+
+func (r *SourcesRepository) CompileXml(path string, rules *XmlExtractRules) (errs []string) {
+  switch rules.Preset {
+  case "", "rss", "atom":
+  default:
+    return []string{fmt.Sprintf("%s.preset: preset %s not supported", path, rules.Preset)}
+  }
+  rules = r.XmlPreset(rules)
+  if rules.Container != "" {
+    if _, err := r.Xpath(rules.Container, rules.Namespaces); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.container: %v", path, err))
+    }
+  }
+  if rules.List != "" {
+    if _, err := r.Xpath(rules.List, rules.Namespaces); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.list: %v", path, err))
+    }
+  }
+  errs = append(errs, r.CompileXmlFields(path+".fields", rules.Fields, rules.Namespaces)...)
+  errs = append(errs, r.CompileFilters(path+".filters", rules.Filters)...)
+  return
+}
+
+func (r *SourcesRepository) CompileXmlFields(path string, fields []*XmlExtractField, namespaces map[string]string) (errs []string) {
+  for i, field := range fields {
+    path := fmt.Sprintf("%s[%d]", path, i)
+    if field.Name == "" {
+      errs = append(errs, fmt.Sprintf("%s.name: name is required", path))
+    }
+    if field.Path == "" {
+      errs = append(errs, fmt.Sprintf("%s.path: path is required", path))
+    } else if _, err := r.Xpath(field.Path, namespaces); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.path: %v", path, err))
+    }
+    errs = append(errs, r.CompileSteps(path, field.RegexReplace, field.RegexExtract, field.Transforms)...)
+    errs = append(errs, r.CompileXmlFields(path+".fields", field.Fields, namespaces)...)
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileSteps(path string, regexReplace []*RegexReplace, regexExtract *RegexExtract, transforms []*Transform) (errs []string) {
+  for i, replace := range regexReplace {
+    if _, err := r.Regexp(replace.Pattern); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.regex_replace[%d].pattern: %v", path, i, err))
+    }
+  }
+
+  if regexExtract != nil {
+    m, err := r.Regexp(regexExtract.Pattern)
+    if err != nil {
+      errs = append(errs, fmt.Sprintf("%s.regex_extract.pattern: %v", path, err))
+    } else if regexExtract.Group != "" {
+      group, err := strconv.Atoi(regexExtract.Group)
+      if err != nil {
+        group = m.SubexpIndex(regexExtract.Group)
+      }
+      if group < 0 || group > m.NumSubexp() {
+        errs = append(errs, fmt.Sprintf("%s.regex_extract.group: group %s not exists", path, regexExtract.Group))
+      }
+    }
+    switch regexExtract.Miss {
+    case "", "drop", "null", "skip":
+    default:
+      errs = append(errs, fmt.Sprintf("%s.regex_extract.miss: miss %s not supported", path, regexExtract.Miss))
+    }
+  }
+
+  for i, transform := range transforms {
+    path := fmt.Sprintf("%s.transforms[%d]", path, i)
+    switch transform.Type {
+    case "trim", "unescape", "lower", "int", "float", "bool", "default":
+    case "date", "datetime":
+      if transform.Timezone != "" {
+        if _, err := time.LoadLocation(transform.Timezone); err != nil {
+          errs = append(errs, fmt.Sprintf("%s.timezone: %v", path, err))
+        }
+      }
+    case "url":
+      if _, err := url.Parse(transform.Value); err != nil {
+        errs = append(errs, fmt.Sprintf("%s.value: %v", path, err))
+      }
+    case "split":
+      if transform.Separator == "" {
+        errs = append(errs, fmt.Sprintf("%s.separator: separator is required", path))
+      }
+    default:
+      errs = append(errs, fmt.Sprintf("%s.type: transform %s not supported", path, transform.Type))
+    }
+  }
+
+  return
+}
+
+func (r *SourcesRepository) CompileComputed(path string, computed []*ComputedField) (errs []string) {
+  for i, field := range computed {
+    path := fmt.Sprintf("%s[%d]", path, i)
+    if field.Name == "" {
+      errs = append(errs, fmt.Sprintf("%s.name: name is required", path))
+    }
+    if _, err := r.ParseTemplate(field.Template); err != nil {
+      errs = append(errs, fmt.Sprintf("%s.template: %v", path, err))
+    }
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileFilters(path string, filters []*Filter) (errs []string) {
+  for i, filter := range filters {
+    path := fmt.Sprintf("%s[%d]", path, i)
+    switch filter.Op {
+    case "":
+    case "exists", "not_exists", "eq", "ne", "contains", "not_contains":
+    case "regex":
+      if _, err := r.Regexp(filter.Value); err != nil {
+        errs = append(errs, fmt.Sprintf("%s.value: %v", path, err))
+      }
+    case "gt", "gte", "lt", "lte":
+      if _, err := strconv.ParseFloat(filter.Value, 64); err != nil {
+        errs = append(errs, fmt.Sprintf("%s.value: %v", path, err))
+      }
+    default:
+      errs = append(errs, fmt.Sprintf("%s.op: op %s not supported", path, filter.Op))
+    }
+    if filter.Op != "" && filter.Field == "" {
+      errs = append(errs, fmt.Sprintf("%s.field: field is required", path))
+    }
+    errs = append(errs, r.CompileFilters(path+".all", filter.All)...)
+    errs = append(errs, r.CompileFilters(path+".any", filter.Any)...)
+    if filter.Not != nil {
+      errs = append(errs, r.CompileFilters(path+".not", []*Filter{filter.Not})...)
+    }
+  }
+  return
+}
+
+func (r *SourcesRepository) CompileSchema(path string, schema *ExtractSchema) (errs []string) {
+  switch schema.Invalid {
+  case "", "drop", "quarantine":
+  default:
+    errs = append(errs, fmt.Sprintf("%s.invalid: mode %s not supported", path, schema.Invalid))
+  }
+  for i, field := range schema.Fields {
+    path := fmt.Sprintf("%s.fields[%d]", path, i)
+    if field.Name == "" {
+      errs = append(errs, fmt.Sprintf("%s.name: name is required", path))
+    }
+    switch field.Type {
+    case "", "string", "int", "float", "bool", "array", "object":
+    default:
+      errs = append(errs, fmt.Sprintf("%s.type: type %s not supported", path, field.Type))
+      continue
+    }
+    if field.Default != "" {
+      if _, err := r.SchemaDefault(field); err != nil {
+        errs = append(errs, fmt.Sprintf("%s.default: %v", path, err))
+      }
+    }
+  }
   return
 }
 
@@ -0,0 +1,1312 @@
+package repositories
+
+import (
+  "fmt"
+  "net/http"
+  "reflect"
+  "strings"
+  "testing"
+  "time"
+
+  "github.com/PuerkitoBio/goquery"
+  "github.com/antchfx/xmlquery"
+  "github.com/tidwall/gjson"
+
+  "taoniu.local/crawls/spiders/common"
+  "taoniu.local/crawls/spiders/models"
+)
+
+func newDocument(t *testing.T, content string) *goquery.Document {
+  doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+  if err != nil {
+    t.Fatalf("parse document: %v", err)
+  }
+  return doc
+}
+
+func TestSourcesOutput(t *testing.T) {
+  r := &SourcesRepository{}
+  doc := newDocument(t, `<div id="post"><p>Hello <b>world</b> <a href="/x" onclick="alert(1)">link</a></p><script>alert(1)</script></div>`)
+  s := doc.Find("#post")
+
+  tests := []struct {
+    output    string
+    allowTags []string
+    expected  string
+  }{
+    {"", nil, "Hello world linkalert(1)"},
+    {"html", nil, `<p>Hello <b>world</b> <a href="/x" onclick="alert(1)">link</a></p><script>alert(1)</script>`},
+    {"outer_html", nil, `<div id="post"><p>Hello <b>world</b> <a href="/x" onclick="alert(1)">link</a></p><script>alert(1)</script></div>`},
+    {"sanitized_html", nil, `<p>Hello <b>world</b> <a href="/x" rel="nofollow">link</a></p>`},
+    {"sanitized_html", []string{"p"}, `<p>Hello world link</p>`},
+    {"sanitized_html", []string{"p", "a"}, `<p>Hello world <a href="/x" rel="nofollow">link</a></p>`},
+    {"markdown", nil, "Hello **world** [link](/x)"},
+  }
+  for _, test := range tests {
+    value, err := r.Output(s, &HtmlExtractField{Output: test.output, AllowTags: test.allowTags})
+    if err != nil {
+      t.Errorf("output %q: %v", test.output, err)
+      continue
+    }
+    if value != test.expected {
+      t.Errorf("output %q allow %v: got %q, want %q", test.output, test.allowTags, value, test.expected)
+    }
+  }
+}
+
+func TestSourcesSanitize(t *testing.T) {
+  r := &SourcesRepository{}
+  tests := []struct {
+    content  string
+    tags     []string
+    expected string
+  }{
+    {`<img src="https://a.com/1.png" onerror="x()">`, nil, `<img src="https://a.com/1.png">`},
+    {`<a href="javascript:alert(1)">x</a>`, []string{"a"}, `x`},
+    {`<img src="https://a.com/1.png" alt="a" width="10">`, []string{"img"}, `<img src="https://a.com/1.png" alt="a">`},
+    {`<iframe src="https://a.com"></iframe><p>x</p>`, []string{"p"}, `<p>x</p>`},
+  }
+  for _, test := range tests {
+    if value := r.Sanitize(test.content, test.tags); value != test.expected {
+      t.Errorf("sanitize %q %v: got %q, want %q", test.content, test.tags, value, test.expected)
+    }
+  }
+}
+
+func TestSourcesTransform(t *testing.T) {
+  r := &SourcesRepository{}
+  page := r.Page("https://example.com/news/1.html", nil)
+
+  tests := []struct {
+    value      interface{}
+    transforms []*Transform
+    expected   interface{}
+  }{
+    {"  a \n  b  ", []*Transform{{Type: "trim"}}, "a b"},
+    {"&lt;b&gt; &amp;", []*Transform{{Type: "unescape"}}, "<b> &"},
+    {"ABC", []*Transform{{Type: "lower"}}, "abc"},
+    {" 42 ", []*Transform{{Type: "int"}}, int64(42)},
+    {float64(42), []*Transform{{Type: "int"}}, int64(42)},
+    {"3.5", []*Transform{{Type: "float"}}, 3.5},
+    {"true", []*Transform{{Type: "bool"}}, true},
+    {"2023/05/06 07:08", []*Transform{{Type: "date", Layout: "2006/01/02 15:04", Timezone: "UTC"}}, "2023-05-06T07:08:00Z"},
+    {"../2.html", []*Transform{{Type: "url"}}, "https://example.com/2.html"},
+    {"/a", []*Transform{{Type: "url", Value: "https://cdn.example.com"}}, "https://cdn.example.com/a"},
+    {"a, b,,c ", []*Transform{{Type: "split", Separator: ","}}, []interface{}{"a", "b", "c"}},
+    {[]interface{}{" A ", "B"}, []*Transform{{Type: "trim"}, {Type: "lower"}}, []interface{}{"a", "b"}},
+    {"", []*Transform{{Type: "default", Value: "n/a"}}, "n/a"},
+    {nil, []*Transform{{Type: "default", Value: "n/a"}}, "n/a"},
+    {[]interface{}{}, []*Transform{{Type: "default", Value: "n/a"}}, "n/a"},
+    {"x", []*Transform{{Type: "default", Value: "n/a"}}, "x"},
+    {" 7 ", []*Transform{{Type: "trim"}, {Type: "int"}}, int64(7)},
+  }
+  for _, test := range tests {
+    value, err := r.Transform(test.value, test.transforms, page)
+    if err != nil {
+      t.Errorf("transform %v %q: %v", test.value, test.transforms[0].Type, err)
+      continue
+    }
+    if !reflect.DeepEqual(value, test.expected) {
+      t.Errorf("transform %v %q: got %#v, want %#v", test.value, test.transforms[0].Type, value, test.expected)
+    }
+  }
+
+  for _, transform := range []*Transform{{Type: "int"}, {Type: "bool"}, {Type: "date"}, {Type: "unknown"}} {
+    if _, err := r.Transform("abc", []*Transform{transform}, page); err == nil {
+      t.Errorf("transform %q: expected error", transform.Type)
+    }
+  }
+}
+
+func TestSourcesTransformMissing(t *testing.T) {
+  r := &SourcesRepository{}
+  doc := newDocument(t, `<div class="item"><h2>Title</h2><span class="empty"></span></div>`)
+
+  fields := []*HtmlExtractField{
+    {Name: "title", Node: &HtmlExtractNode{Selector: "h2"}},
+    {Name: "author", Node: &HtmlExtractNode{Selector: ".author"}, Transforms: []*Transform{{Type: "default", Value: "anonymous"}}},
+    {Name: "empty", Node: &HtmlExtractNode{Selector: ".empty"}, Transforms: []*Transform{{Type: "default", Value: "none"}}},
+    {Name: "views", Node: &HtmlExtractNode{Selector: ".views"}, Transforms: []*Transform{{Type: "int"}, {Type: "default", Value: "0"}, {Type: "int"}}},
+    {Name: "link", Node: &HtmlExtractNode{Attr: "href"}, Transforms: []*Transform{{Type: "default", Value: "#"}}},
+    {Name: "summary", Node: &HtmlExtractNode{Selector: ".summary"}, Transforms: []*Transform{{Type: "trim"}}},
+  }
+  data, err := r.ExtractHtmlFields(doc.Find(".item"), fields, nil)
+  if err != nil {
+    t.Fatalf("extract html fields: %v", err)
+  }
+  expected := map[string]interface{}{
+    "title":  "Title",
+    "author": "anonymous",
+    "empty":  "none",
+    "views":  int64(0),
+    "link":   "#",
+  }
+  if !reflect.DeepEqual(data, expected) {
+    t.Errorf("html fields: got %#v, want %#v", data, expected)
+  }
+
+  content := gjson.Parse(`{"title":"Title"}`)
+  data, err = r.ExtractJsonFields(&content, []*JsonExtractField{
+    {Name: "title", Path: "title"},
+    {Name: "author", Path: "author", Transforms: []*Transform{{Type: "default", Value: "anonymous"}}},
+    {Name: "summary", Path: "summary", Transforms: []*Transform{{Type: "trim"}}},
+  }, nil)
+  if err != nil {
+    t.Fatalf("extract json fields: %v", err)
+  }
+  expected = map[string]interface{}{
+    "title":  "Title",
+    "author": "anonymous",
+  }
+  if !reflect.DeepEqual(data, expected) {
+    t.Errorf("json fields: got %#v, want %#v", data, expected)
+  }
+}
+
+func TestSourcesRegexExtract(t *testing.T) {
+  r := &SourcesRepository{}
+  tests := []struct {
+    value    interface{}
+    extract  *RegexExtract
+    expected interface{}
+    matched  bool
+  }{
+    {"id=123&page=2", &RegexExtract{Pattern: `id=\d+`}, "id=123", true},
+    {"id=123&page=2", &RegexExtract{Pattern: `id=(\d+)`}, "123", true},
+    {"id=123&page=2", &RegexExtract{Pattern: `(\w+)=(\d+)`, Group: "2"}, "123", true},
+    {"id=123&page=2", &RegexExtract{Pattern: `page=(?P<page>\d+)`, Group: "page"}, "2", true},
+    {"id=123&page=2", &RegexExtract{Pattern: `=(\d+)`, All: true}, []interface{}{"123", "2"}, true},
+    {"id=123", &RegexExtract{Pattern: `page=(\d+)`}, nil, false},
+    {"id=123", &RegexExtract{Pattern: `page=(\d+)`, All: true}, nil, false},
+    {"id=123", &RegexExtract{Pattern: `id=(\d+)`, Group: "5"}, nil, false},
+    {float64(2023), &RegexExtract{Pattern: `^(\d{2})`}, "20", true},
+    {[]interface{}{"a1", "b", "c3"}, &RegexExtract{Pattern: `\d`}, []interface{}{"1", "3"}, true},
+    {[]interface{}{"a", "b"}, &RegexExtract{Pattern: `\d`}, []interface{}(nil), false},
+  }
+  for _, test := range tests {
+    value, matched, err := r.RegexExtract(test.value, test.extract)
+    if err != nil {
+      t.Errorf("regex extract %v %q: %v", test.value, test.extract.Pattern, err)
+      continue
+    }
+    if matched != test.matched || !reflect.DeepEqual(value, test.expected) {
+      t.Errorf("regex extract %v %q: got %#v %v, want %#v %v", test.value, test.extract.Pattern, value, matched, test.expected, test.matched)
+    }
+  }
+
+  if _, _, err := r.RegexExtract("x", &RegexExtract{Pattern: `(`}); err == nil {
+    t.Error("regex extract invalid pattern: expected error")
+  }
+}
+
+func TestSourcesRegexExtractMiss(t *testing.T) {
+  r := &SourcesRepository{}
+  doc := newDocument(t, `<div class="item"><span class="price">price: n/a</span></div>`)
+  field := func(miss string) []*HtmlExtractField {
+    return []*HtmlExtractField{
+      {Name: "price", Node: &HtmlExtractNode{Selector: ".price"}, RegexExtract: &RegexExtract{Pattern: `(\d+)`, Miss: miss}},
+    }
+  }
+
+  data, err := r.ExtractHtmlFields(doc.Find(".item"), field(""), nil)
+  if err != nil || !reflect.DeepEqual(data, map[string]interface{}{}) {
+    t.Errorf("miss omit: got %#v %v", data, err)
+  }
+  data, err = r.ExtractHtmlFields(doc.Find(".item"), field("null"), nil)
+  if err != nil || !reflect.DeepEqual(data, map[string]interface{}{"price": nil}) {
+    t.Errorf("miss null: got %#v %v", data, err)
+  }
+  if _, err = r.ExtractHtmlFields(doc.Find(".item"), field("skip"), nil); err == nil {
+    t.Error("miss skip: expected error")
+  }
+}
+
+func TestSourcesHtmlAll(t *testing.T) {
+  r := &SourcesRepository{}
+  doc := newDocument(t, `<ul><li><a href="/1">One</a><span class="tag">a</span><span class="tag">b</span></li><li><a href="/2">Two</a></li></ul>`)
+  rules := &HtmlExtractRules{
+    Container: &HtmlExtractNode{Selector: "ul"},
+    List:      &HtmlExtractNode{Selector: "li"},
+    Fields: []*HtmlExtractField{
+      {Name: "title", Node: &HtmlExtractNode{Selector: "a"}},
+      {Name: "tags", Node: &HtmlExtractNode{Selector: ".tag", All: true}},
+    },
+  }
+  result, err := r.ExtractHtmlList(doc, rules, nil)
+  if err != nil {
+    t.Fatalf("extract html list: %v", err)
+  }
+  expected := []map[string]interface{}{
+    {"title": "One", "tags": []interface{}{"a", "b"}},
+    {"title": "Two"},
+  }
+  if !reflect.DeepEqual(result, expected) {
+    t.Errorf("html list: got %#v, want %#v", result, expected)
+  }
+
+  errs := r.CompileHtmlFields("fields", []*HtmlExtractField{
+    {Name: "tags", Node: &HtmlExtractNode{Selector: ".tag", All: true}, Match: "a"},
+  })
+  if len(errs) != 1 || errs[0] != "fields[0].match: match not supported with node.all" {
+    t.Errorf("compile match with all: got %v", errs)
+  }
+}
+
+func TestSourcesScriptJson(t *testing.T) {
+  r := &SourcesRepository{}
+  tests := []struct {
+    script   string
+    variable string
+    expected string
+  }{
+    {`{"a":1}`, "", `{"a":1}`},
+    {` [1,2] `, "", `[1,2]`},
+    {`callback({"a":1});`, "", `{"a":1}`},
+    {`window.jQuery_1 ( [1] )`, "", `[1]`},
+    {`var x = 1; window.__DATA__ = {"a":{"b":[1]}}; var y = {};`, `window\.__DATA__`, `{"a":{"b":[1]}}`},
+  }
+  for _, test := range tests {
+    value, err := r.ScriptJson(test.script, test.variable)
+    if err != nil {
+      t.Errorf("script json %q: %v", test.script, err)
+      continue
+    }
+    if value != test.expected {
+      t.Errorf("script json %q: got %q, want %q", test.script, value, test.expected)
+    }
+  }
+
+  for _, test := range []struct {
+    script   string
+    variable string
+  }{
+    {`var a = 1;`, ""},
+    {`var a = 1;`, "b"},
+    {`cb({"a":`, ""},
+  } {
+    if _, err := r.ScriptJson(test.script, test.variable); err == nil {
+      t.Errorf("script json %q: expected error", test.script)
+    }
+  }
+}
+
+func TestSourcesValidateScroll(t *testing.T) {
+  r := &SourcesRepository{}
+  extractRules := map[string]*ExtractRules{
+    "articles": {Json: &JsonExtractRules{List: "data"}},
+  }
+  if errs := r.ValidateParams(map[string]interface{}{"scroll": "articles.#.createtime"}, extractRules); len(errs) > 0 {
+    t.Errorf("validate scroll: %v", errs)
+  }
+  errs := r.ValidateParams(map[string]interface{}{"scroll": "news.#.createtime"}, extractRules)
+  if len(errs) != 1 || errs[0] != "params.scroll: rule news not exists" {
+    t.Errorf("validate missing scroll rule: got %v", errs)
+  }
+  extractRules["pages"] = &ExtractRules{Internal: true, Json: &JsonExtractRules{List: "pages"}}
+  errs = r.ValidateParams(map[string]interface{}{"scroll": "pages.#.createtime"}, extractRules)
+  if len(errs) != 1 || errs[0] != "params.scroll: rule pages is internal" {
+    t.Errorf("validate internal scroll rule: got %v", errs)
+  }
+}
+
+func TestSourcesValidateSplit(t *testing.T) {
+  tasks := newTasksRepository(t, nil)
+  r := tasks.Source()
+  parent := newSource(t, tasks, &models.Source{
+    Url: "https://example.com/categories.html",
+    ExtractRules: map[string]interface{}{
+      "categories": map[string]interface{}{"json": map[string]interface{}{"list": "data"}},
+      "raw":        map[string]interface{}{"internal": true, "json": map[string]interface{}{"list": "raw"}},
+    },
+  })
+
+  tests := []struct {
+    paths    []interface{}
+    expected []string
+  }{
+    {[]interface{}{"categories.#.id"}, nil},
+    {[]interface{}{"missing.#.id"}, []string{fmt.Sprintf("params.split.%s[0]: rule missing not exists", parent.Slug)}},
+    {[]interface{}{"categories.#.id", "raw.#.id"}, []string{fmt.Sprintf("params.split.%s[1]: rule raw is internal", parent.Slug)}},
+  }
+  for _, test := range tests {
+    params := map[string]interface{}{
+      "split": map[string]interface{}{parent.Slug: test.paths},
+    }
+    if errs := r.ValidateParams(params, map[string]*ExtractRules{}); !reflect.DeepEqual(errs, test.expected) {
+      t.Errorf("validate split %v: got %v, want %v", test.paths, errs, test.expected)
+    }
+  }
+  errs := r.ValidateParams(map[string]interface{}{
+    "split": map[string]interface{}{"missing": []interface{}{"categories.#.id"}},
+  }, map[string]*ExtractRules{})
+  if len(errs) != 1 || errs[0] != "params.split.missing: source not exists" {
+    t.Errorf("validate split missing source: got %v", errs)
+  }
+}
+
+func TestSourcesTransformDatetime(t *testing.T) {
+  r := &SourcesRepository{}
+  source := &models.Source{Params: map[string]interface{}{"timezone": "Asia/Shanghai"}}
+  page := &ExtractPage{
+    Location: r.Location(source),
+    Time:     time.Date(2024, 1, 2, 2, 30, 0, 0, time.UTC),
+  }
+
+  tests := []struct {
+    value     interface{}
+    transform *Transform
+    expected  string
+  }{
+    {"昨天 12:00", &Transform{Type: "datetime"}, "2024-01-01T12:00:00+08:00"},
+    {"3天前", &Transform{Type: "datetime"}, "2023-12-30T10:30:00+08:00"},
+    {"2023-05-06 07:08", &Transform{Type: "datetime"}, "2023-05-06T07:08:00+08:00"},
+    {"2023-05-06 07:08", &Transform{Type: "datetime", Timezone: "UTC"}, "2023-05-06T07:08:00Z"},
+    {float64(1704162600), &Transform{Type: "datetime"}, "2024-01-02T10:30:00+08:00"},
+  }
+  for _, test := range tests {
+    value, err := r.Transform(test.value, []*Transform{test.transform}, page)
+    if err != nil {
+      t.Errorf("datetime %v: %v", test.value, err)
+      continue
+    }
+    if value != test.expected {
+      t.Errorf("datetime %v: got %v, want %v", test.value, value, test.expected)
+    }
+  }
+
+  if location := r.Location(&models.Source{Params: map[string]interface{}{"timezone": "Nowhere/City"}}); location != time.Local {
+    t.Errorf("invalid timezone: got %v, want Local", location)
+  }
+}
+
+func TestSourcesMetadata(t *testing.T) {
+  r := &SourcesRepository{}
+  doc := newDocument(t, `<html><head>
+<meta property="og:title" content="OG Title">
+<meta property="og:image" content="/cover.png">
+<meta name="description" content="Summary">
+<script type="application/ld+json">{"@type":"NewsArticle","headline":"LD Title","datePublished":"2024-01-02T10:30:00+08:00","author":{"name":"Alice"}}</script>
+</head><body><article><h1>Body Title</h1></article></body></html>`)
+  page := r.Page("https://example.com/news/1.html", doc)
+
+  data, err := r.ExtractMetadata(doc, &MetadataExtractRules{}, page)
+  if err != nil {
+    t.Fatalf("extract metadata: %v", err)
+  }
+  if _, ok := data["twitter"]; ok {
+    t.Errorf("metadata: empty twitter group emitted: %#v", data["twitter"])
+  }
+  for key, expected := range map[string]interface{}{
+    "title":       "LD Title",
+    "description": "Summary",
+    "image":       "https://example.com/cover.png",
+    "author":      "Alice",
+    "type":        "NewsArticle",
+  } {
+    if data[key] != expected {
+      t.Errorf("metadata %s: got %#v, want %#v", key, data[key], expected)
+    }
+  }
+
+  empty := newDocument(t, `<html><head><title>x</title></head><body><p>x</p></body></html>`)
+  if data, err := r.ExtractMetadata(empty, &MetadataExtractRules{}, r.Page("https://example.com", empty)); err == nil {
+    t.Errorf("extract empty metadata: expected error, got %#v", data)
+  }
+}
+
+func TestSourcesMetadataFallback(t *testing.T) {
+  r := &SourcesRepository{}
+  doc := newDocument(t, `<html><head>
+<meta property="og:title" content="OG Title">
+<meta property="og:site_name" content="Example">
+</head><body><article><h1>Body Title</h1></article></body></html>`)
+  page := r.Page("https://example.com/news/1.html", doc)
+
+  data, err := r.ExtractHtml(doc, &HtmlExtractRules{
+    Container: &HtmlExtractNode{Selector: "article"},
+    Fields: []*HtmlExtractField{
+      {Name: "title", Node: &HtmlExtractNode{Selector: "h1"}, Metadata: "title"},
+      {Name: "summary", Node: &HtmlExtractNode{Selector: ".summary"}, Metadata: "opengraph.title", Transforms: []*Transform{{Type: "lower"}}},
+      {Name: "site", Node: &HtmlExtractNode{Selector: ".site"}, Metadata: "site_name"},
+      {Name: "author", Node: &HtmlExtractNode{Selector: ".author"}, Metadata: "author", Transforms: []*Transform{{Type: "default", Value: "anonymous"}}},
+      {Name: "tags", Node: &HtmlExtractNode{Selector: ".tags"}, Metadata: "keywords"},
+    },
+  }, page)
+  if err != nil {
+    t.Fatalf("extract html: %v", err)
+  }
+  expected := map[string]interface{}{
+    "title":   "Body Title",
+    "summary": "og title",
+    "site":    "Example",
+    "author":  "anonymous",
+  }
+  if !reflect.DeepEqual(data, expected) {
+    t.Errorf("metadata fallback: got %#v, want %#v", data, expected)
+  }
+  if page.Metadata == nil {
+    t.Error("metadata fallback: expected metadata to be cached on the page")
+  }
+}
+
+func TestSourcesSplit(t *testing.T) {
+  r := &SourcesRepository{}
+  page := r.Page("https://example.com/index.html", nil)
+  content := []byte(`{"ids":["1","{{url}}","3"],"items":[{"slug":"btc","id":1},{"id":2},{"slug":"eth","id":3}]}`)
+
+  tests := []struct {
+    url      string
+    query    []interface{}
+    path     string
+    expected []string
+  }{
+    {
+      "https://example.com/news/{}.html",
+      nil,
+      "ids",
+      []string{"https://example.com/news/1.html", "https://example.com/news/%7B%7Burl%7D%7D.html", "https://example.com/news/3.html"},
+    },
+    {
+      "https://example.com/{{.slug}}/list.html",
+      []interface{}{
+        map[string]interface{}{"name": "id", "value": "{{.id}}"},
+        map[string]interface{}{"name": "page", "value": "1"},
+      },
+      "items",
+      []string{"https://example.com/btc/list.html?id=1&page=1", "https://example.com/eth/list.html?id=3&page=1"},
+    },
+    {
+      "https://example.com/list.html",
+      []interface{}{
+        map[string]interface{}{"name": "id", "value": "$0"},
+      },
+      "ids",
+      []string{"https://example.com/list.html?id=1", "https://example.com/list.html?id=%7B%7Burl%7D%7D", "https://example.com/list.html?id=3"},
+    },
+  }
+  for _, test := range tests {
+    source := &models.Source{Url: test.url, Params: map[string]interface{}{}}
+    if test.query != nil {
+      source.Params["query"] = test.query
+    }
+    if urls := r.Split(source, content, test.path, page); !reflect.DeepEqual(urls, test.expected) {
+      t.Errorf("split %s %s: got %v, want %v", test.url, test.path, urls, test.expected)
+    }
+  }
+
+  templates.Range(func(key, _ interface{}) bool {
+    if strings.Contains(key.(string), "{{url}}") {
+      t.Errorf("split: scraped value %q parsed as a template", key)
+    }
+    return true
+  })
+}
+
+func TestSourcesCompileRuleTypes(t *testing.T) {
+  r := &SourcesRepository{}
+  html := &HtmlExtractRules{
+    Container: &HtmlExtractNode{Selector: "body"},
+    Fields:    []*HtmlExtractField{{Name: "title", Node: &HtmlExtractNode{Selector: "h1"}}},
+  }
+  json := &JsonExtractRules{Fields: []*JsonExtractField{{Name: "title", Path: "title"}}}
+  xml := &XmlExtractRules{Preset: "rss"}
+
+  tests := []struct {
+    rules    *ExtractRules
+    expected string
+  }{
+    {&ExtractRules{Html: html}, ""},
+    {&ExtractRules{}, "detail: rules is empty"},
+    {&ExtractRules{Html: html, Json: json}, "detail: only one rule type allowed, got html, json"},
+    {&ExtractRules{Html: html, Json: json, Xml: xml}, "detail: only one rule type allowed, got html, json, xml"},
+  }
+  for _, test := range tests {
+    errs := r.CompileRules("detail", test.rules)
+    if test.expected == "" {
+      if len(errs) > 0 {
+        t.Errorf("compile rules: unexpected errors %v", errs)
+      }
+      continue
+    }
+    if len(errs) == 0 || errs[0] != test.expected {
+      t.Errorf("compile rules: got %v, want %q", errs, test.expected)
+    }
+  }
+}
+
+func TestSourcesJsonFallbacks(t *testing.T) {
+  r := &SourcesRepository{}
+  rules := &JsonExtractRules{
+    Container:          "data",
+    ContainerFallbacks: []string{"result", "payload.data"},
+    List:               "items",
+    ListFallbacks:      []string{"list"},
+    Fields:             []*JsonExtractField{{Name: "id", Path: "id"}},
+  }
+
+  tests := []struct {
+    content string
+    ids     []interface{}
+    matches map[string]interface{}
+  }{
+    {`{"data":{"items":[{"id":1}]}}`, []interface{}{float64(1)}, map[string]interface{}{}},
+    {`{"result":{"items":[{"id":2}]}}`, []interface{}{float64(2)}, map[string]interface{}{"data": "result"}},
+    {`{"payload":{"data":{"list":[{"id":3},{"id":4}]}}}`, []interface{}{float64(3), float64(4)}, map[string]interface{}{"data": "payload.data", "items": "list"}},
+    {`{"other":{}}`, nil, nil},
+  }
+  for _, test := range tests {
+    page := r.Page("https://example.com/api", nil)
+    page.Rule = "news"
+    page.Matches = map[string]interface{}{}
+    result, err := r.ExtractJsonList(test.content, rules, page)
+    if test.ids == nil {
+      if err == nil {
+        t.Errorf("json fallbacks %s: expected error", test.content)
+      }
+      continue
+    }
+    if err != nil {
+      t.Errorf("json fallbacks %s: %v", test.content, err)
+      continue
+    }
+    var ids []interface{}
+    for _, item := range result {
+      ids = append(ids, item["id"])
+    }
+    if !reflect.DeepEqual(ids, test.ids) {
+      t.Errorf("json fallbacks %s: got %v, want %v", test.content, ids, test.ids)
+    }
+    matches, _ := page.Matches["news"].(map[string]interface{})
+    if matches == nil {
+      matches = map[string]interface{}{}
+    }
+    if !reflect.DeepEqual(matches, test.matches) {
+      t.Errorf("json fallbacks %s: got matches %v, want %v", test.content, matches, test.matches)
+    }
+  }
+
+  page := r.Page("https://example.com/api", nil)
+  page.Rule = "detail"
+  page.Matches = map[string]interface{}{}
+  detail := &JsonExtractRules{
+    Container:          "data",
+    ContainerFallbacks: []string{"result"},
+    Fields:             []*JsonExtractField{{Name: "id", Path: "id"}},
+  }
+  data, err := r.ExtractJson(`{"result":{"id":5}}`, detail, page)
+  if err != nil || data["id"] != float64(5) || page.Matches["detail"].(map[string]interface{})["data"] != "result" {
+    t.Errorf("json container fallback: got %v %v matches %v", data, err, page.Matches)
+  }
+
+  errs := r.CompileJson("json", &JsonExtractRules{ListFallbacks: []string{"list", "items[0"}})
+  expected := []string{"json.list_fallbacks: fallbacks require a primary path", "json.list_fallbacks[1]: "}
+  if len(errs) != 2 || errs[0] != expected[0] || !strings.HasPrefix(errs[1], expected[1]) {
+    t.Errorf("compile json fallbacks: got %v", errs)
+  }
+}
+
+func TestSourcesHtmlFallbacks(t *testing.T) {
+  r := &SourcesRepository{}
+  doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><body><h2 class="headline">Old</h2><div class="byline"><span>Alice</span></div></body></html>`))
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  tests := []struct {
+    node     *HtmlExtractNode
+    text     string
+    matches  map[string]interface{}
+    hasError bool
+  }{
+    {&HtmlExtractNode{Selector: "h2", Fallbacks: []string{"h1"}}, "Old", map[string]interface{}{}, false},
+    {&HtmlExtractNode{Selector: "h1.title", Fallbacks: []string{"h1", "h2.headline"}}, "Old", map[string]interface{}{"h1.title": "h2.headline"}, false},
+    {&HtmlExtractNode{Selector: "h1", Fallbacks: []string{"h3"}}, "", map[string]interface{}{}, false},
+    {&HtmlExtractNode{Selector: "//p", Type: "xpath", Fallbacks: []string{"//div[@class='byline']/span"}}, "Alice", map[string]interface{}{"//p": "//div[@class='byline']/span"}, false},
+    {&HtmlExtractNode{Selector: "//p", Type: "xpath", Fallbacks: []string{"//p["}}, "", nil, true},
+  }
+  for _, test := range tests {
+    page := r.Page("https://example.com/news", doc)
+    page.Rule = "news"
+    page.Matches = map[string]interface{}{}
+    selection, err := r.SelectNode(doc.Selection, test.node, page)
+    if test.hasError {
+      if err == nil {
+        t.Errorf("html fallbacks %v: expected error", test.node.Fallbacks)
+      }
+      continue
+    }
+    if err != nil {
+      t.Errorf("html fallbacks %v: %v", test.node.Fallbacks, err)
+      continue
+    }
+    if text := selection.Text(); text != test.text {
+      t.Errorf("html fallbacks %v: got %q, want %q", test.node.Fallbacks, text, test.text)
+    }
+    matches, _ := page.Matches["news"].(map[string]interface{})
+    if matches == nil {
+      matches = map[string]interface{}{}
+    }
+    if !reflect.DeepEqual(matches, test.matches) {
+      t.Errorf("html fallbacks %v: got matches %v, want %v", test.node.Fallbacks, matches, test.matches)
+    }
+  }
+
+  page := r.Page("https://example.com/news", nil)
+  page.Rule = "detail"
+  page.Matches = map[string]interface{}{}
+  data, err := r.ExtractJson(`{"headline":"Old","author":{"name":"Alice"}}`, &JsonExtractRules{
+    Fields: []*JsonExtractField{
+      {Name: "title", Path: "title", Fallbacks: []string{"headline"}},
+      {Name: "author", Path: "author.name"},
+      {Name: "summary", Path: "summary", Fallbacks: []string{"description"}},
+    },
+  }, page)
+  expected := map[string]interface{}{"title": "Old", "author": "Alice"}
+  if err != nil || !reflect.DeepEqual(data, expected) {
+    t.Errorf("json field fallbacks: got %v %v, want %v", data, err, expected)
+  }
+  if !reflect.DeepEqual(page.Matches, map[string]interface{}{"detail": map[string]interface{}{"title": "headline"}}) {
+    t.Errorf("json field fallbacks: got matches %v", page.Matches)
+  }
+
+  errs := r.CompileNode("node", &HtmlExtractNode{Selector: "h1", Fallbacks: []string{"h2", "h3["}})
+  if len(errs) != 1 || !strings.HasPrefix(errs[0], "node.fallbacks[1]: ") {
+    t.Errorf("compile html fallbacks: got %v", errs)
+  }
+  errs = r.CompileJsonFields("fields", []*JsonExtractField{{Name: "title", Path: "title", Fallbacks: []string{"items[0"}}})
+  if len(errs) != 1 || !strings.HasPrefix(errs[0], "fields[0].fallbacks[0]: ") {
+    t.Errorf("compile json field fallbacks: got %v", errs)
+  }
+}
+
+func TestSourcesPreview(t *testing.T) {
+  fetcher := &fakeFetcher{
+    handle: func(request *common.FetchRequest) *http.Response {
+      return newResponse(http.StatusOK, map[string]string{"Content-Type": "application/json"}, `{"result":{"items":[{"id":1,"time":100},{"id":2,"time":90}]}}`)
+    },
+  }
+  tasks := newTasksRepository(t, fetcher)
+  r := tasks.Source()
+  r.TasksRepository = tasks
+  if err := tasks.Proxies().Save("preview", "http://127.0.0.1:8080", nil); err != nil {
+    t.Fatalf("save proxy: %v", err)
+  }
+  proxy := tasks.Proxies().All("preview")[0]
+
+  source := &models.Source{
+    Slug: "news",
+    Url:  "https://example.com/api/news?page=1",
+    Params: map[string]interface{}{
+      "proxy":  map[string]interface{}{"pool": "preview"},
+      "scroll": "news.#.time",
+      "query": []interface{}{
+        map[string]interface{}{"name": "page", "value": "$1"},
+      },
+    },
+  }
+  extractRules := map[string]*ExtractRules{
+    "news": {Json: &JsonExtractRules{
+      Container:          "data",
+      ContainerFallbacks: []string{"result"},
+      List:               "items",
+      Fields: []*JsonExtractField{
+        {Name: "id", Path: "id"},
+        {Name: "time", Path: "time"},
+      },
+    }},
+  }
+  newSource(t, tasks, &models.Source{
+    Url: "https://example.com/news/{}.html",
+    Params: map[string]interface{}{
+      "split": map[string]interface{}{"news": []interface{}{"news.#.id"}},
+    },
+  })
+
+  result, err := r.Preview(source, extractRules, "", "")
+  if err != nil {
+    t.Fatalf("preview: %v", err)
+  }
+  if items, _ := result.Result["news"].([]map[string]interface{}); len(items) != 2 {
+    t.Errorf("preview result: got %v", result.Result)
+  }
+  if matches, _ := result.Matches["news"].(map[string]interface{}); matches["data"] != "result" {
+    t.Errorf("preview matches: got %v", result.Matches)
+  }
+  expected := []string{
+    "https://example.com/api/news?page=90",
+    "https://example.com/news/1.html",
+    "https://example.com/news/2.html",
+  }
+  if !reflect.DeepEqual(result.Urls, expected) {
+    t.Errorf("preview urls: got %v, want %v", result.Urls, expected)
+  }
+  if requests := fetcher.pages(); len(requests) != 1 || requests[0].Proxy == "" {
+    t.Errorf("preview requests: got %v", requests)
+  }
+  if after := findProxy(t, tasks.Proxies(), proxy.ID); after.Score != proxy.Score || after.Latency != proxy.Latency || after.Failures != proxy.Failures {
+    t.Errorf("preview: proxy health changed from %+v to %+v", proxy, after)
+  }
+  var count int64
+  tasks.Db.Model(&models.Task{}).Count(&count)
+  if count != 0 {
+    t.Errorf("preview: created %d tasks", count)
+  }
+
+  result, err = r.Preview(source, extractRules, "https://example.com/api/news?page=2", `{"data":{"items":[{"id":3,"time":80}]}}`)
+  if err != nil {
+    t.Fatalf("preview content: %v", err)
+  }
+  if requests := fetcher.pages(); len(requests) != 1 {
+    t.Errorf("preview content: fetched %d pages, want 1", len(requests))
+  }
+  if matches, _ := result.Matches["news"].(map[string]interface{}); len(matches) != 0 {
+    t.Errorf("preview content matches: got %v", result.Matches)
+  }
+  if len(result.Urls) != 2 || result.Urls[0] != "https://example.com/api/news?page=80" {
+    t.Errorf("preview content urls: got %v", result.Urls)
+  }
+}
+
+func newFeed(t *testing.T, content string) *xmlquery.Node {
+  doc, err := xmlquery.Parse(strings.NewReader(content))
+  if err != nil {
+    t.Fatalf("parse feed: %v", err)
+  }
+  return doc
+}
+
+func TestSourcesXmlPresets(t *testing.T) {
+  r := &SourcesRepository{}
+  rss := `<?xml version="1.0"?>
+<rss version="2.0" xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>News</title>
+    <item>
+      <title> Bitcoin rallies </title>
+      <link>https://example.com/1</link>
+      <guid>1</guid>
+      <pubDate>Tue, 02 Jan 2024 10:30:00 GMT</pubDate>
+      <description>short</description>
+      <content:encoded><![CDATA[<p>full</p>]]></content:encoded>
+      <dc:creator>alice</dc:creator>
+    </item>
+    <item>
+      <title>Ether slips</title>
+      <link>https://example.com/2</link>
+      <guid>2</guid>
+      <description>only summary</description>
+      <dc:creator>bob</dc:creator>
+    </item>
+  </channel>
+</rss>`
+  atom := `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>News</title>
+  <entry>
+    <title>Bitcoin rallies</title>
+    <link rel="self" href="https://example.com/feed/1"/>
+    <link rel="alternate" href="https://example.com/1"/>
+    <id>urn:1</id>
+    <published>2024-01-02T10:30:00Z</published>
+    <updated>2024-01-03T10:30:00Z</updated>
+    <content>full</content>
+    <summary>short</summary>
+  </entry>
+  <entry>
+    <title>Ether slips</title>
+    <link href="https://example.com/2"/>
+    <id>urn:2</id>
+    <updated>2024-01-04T10:30:00Z</updated>
+    <summary>only summary</summary>
+  </entry>
+</feed>`
+
+  tests := []struct {
+    name     string
+    content  string
+    rules    *XmlExtractRules
+    expected []map[string]interface{}
+  }{
+    {
+      "rss",
+      rss,
+      &XmlExtractRules{Preset: "rss"},
+      []map[string]interface{}{
+        {"title": "Bitcoin rallies", "link": "https://example.com/1", "guid": "1", "pubDate": "Tue, 02 Jan 2024 10:30:00 GMT", "content": "<p>full</p>"},
+        {"title": "Ether slips", "link": "https://example.com/2", "guid": "2", "content": "only summary"},
+      },
+    },
+    {
+      "atom",
+      atom,
+      &XmlExtractRules{Preset: "atom"},
+      []map[string]interface{}{
+        {"title": "Bitcoin rallies", "link": "https://example.com/1", "guid": "urn:1", "pubDate": "2024-01-02T10:30:00Z", "content": "full"},
+        {"title": "Ether slips", "link": "https://example.com/2", "guid": "urn:2", "pubDate": "2024-01-04T10:30:00Z", "content": "only summary"},
+      },
+    },
+    {
+      "rss override and extra namespace",
+      rss,
+      &XmlExtractRules{
+        Preset:     "rss",
+        Namespaces: map[string]string{"dc": "http://purl.org/dc/elements/1.1/"},
+        Fields: []*XmlExtractField{
+          {Name: "content", Path: "description"},
+          {Name: "author", Path: "dc:creator"},
+        },
+        Filters: []*Filter{{Field: "author", Op: "eq", Value: "bob"}},
+      },
+      []map[string]interface{}{
+        {"title": "Ether slips", "link": "https://example.com/2", "guid": "2", "content": "only summary", "author": "bob"},
+      },
+    },
+    {
+      "custom",
+      rss,
+      &XmlExtractRules{
+        Container: "//channel",
+        List:      "item",
+        Fields:    []*XmlExtractField{{Name: "guid", Path: "guid", Transforms: []*Transform{{Type: "int"}}}},
+      },
+      []map[string]interface{}{{"guid": int64(1)}, {"guid": int64(2)}},
+    },
+  }
+  for _, test := range tests {
+    if errs := r.CompileXml("xml", test.rules); len(errs) > 0 {
+      t.Errorf("xml %s: compile errors %v", test.name, errs)
+      continue
+    }
+    result, err := r.ExtractXmlList(newFeed(t, test.content), test.rules, r.Page("https://example.com/feed", nil))
+    if err != nil {
+      t.Errorf("xml %s: %v", test.name, err)
+      continue
+    }
+    if !reflect.DeepEqual(result, test.expected) {
+      t.Errorf("xml %s: got %v, want %v", test.name, result, test.expected)
+    }
+  }
+
+  data, err := r.ExtractXml(newFeed(t, rss), &XmlExtractRules{
+    Container: "//channel",
+    Fields:    []*XmlExtractField{{Name: "title", Path: "title"}},
+  }, r.Page("https://example.com/feed", nil))
+  if err != nil || data["title"] != "News" {
+    t.Errorf("xml single: got %v %v", data, err)
+  }
+  if _, err := r.ExtractXml(newFeed(t, rss), &XmlExtractRules{Container: "//missing"}, nil); err == nil {
+    t.Error("xml missing container: expected error")
+  }
+
+  for _, rules := range []*XmlExtractRules{
+    {Preset: "json"},
+    {List: "//item[", Fields: []*XmlExtractField{{Name: "title", Path: "title"}}},
+    {List: "//item", Fields: []*XmlExtractField{{Name: "title", Path: "title["}}},
+  } {
+    if errs := r.CompileXml("xml", rules); len(errs) == 0 {
+      t.Errorf("compile xml %+v: expected error", rules)
+    }
+  }
+}
+
+func TestSourcesValidate(t *testing.T) {
+  r := &SourcesRepository{}
+  schema := &ExtractSchema{Fields: []*SchemaField{
+    {Name: "title", Type: "string", Required: true},
+    {Name: "views", Type: "int", Default: "0"},
+    {Name: "price", Type: "float"},
+    {Name: "hot", Type: "bool", Default: "false"},
+    {Name: "tags", Type: "array"},
+    {Name: "author", Type: "object"},
+  }}
+
+  tests := []struct {
+    item     map[string]interface{}
+    expected map[string]interface{}
+    err      string
+  }{
+    {
+      map[string]interface{}{"title": "a"},
+      map[string]interface{}{"title": "a", "views": int64(0), "hot": false},
+      "",
+    },
+    {
+      map[string]interface{}{"title": "a", "views": float64(12), "price": int64(3), "hot": true, "tags": []interface{}{"x"}, "author": map[string]interface{}{"name": "b"}},
+      map[string]interface{}{"title": "a", "views": float64(12), "price": int64(3), "hot": true, "tags": []interface{}{"x"}, "author": map[string]interface{}{"name": "b"}},
+      "",
+    },
+    {
+      map[string]interface{}{"title": "a", "tags": []map[string]interface{}{{"name": "x"}}},
+      map[string]interface{}{"title": "a", "views": int64(0), "hot": false, "tags": []map[string]interface{}{{"name": "x"}}},
+      "",
+    },
+    {map[string]interface{}{"title": ""}, nil, "field title is required"},
+    {map[string]interface{}{"views": int64(1)}, nil, "field title is required"},
+    {map[string]interface{}{"title": "a", "views": 1.5}, nil, "field views is not int"},
+    {map[string]interface{}{"title": "a", "price": "3"}, nil, "field price is not float"},
+    {map[string]interface{}{"title": "a", "tags": "x"}, nil, "field tags is not array"},
+    {map[string]interface{}{"title": "a", "author": "b"}, nil, "field author is not object"},
+  }
+  for _, test := range tests {
+    err := r.ValidateItem(test.item, schema)
+    if test.err != "" {
+      if err == nil || err.Error() != test.err {
+        t.Errorf("validate %v: got %v, want %q", test.item, err, test.err)
+      }
+      continue
+    }
+    if err != nil {
+      t.Errorf("validate %v: %v", test.item, err)
+      continue
+    }
+    if !reflect.DeepEqual(test.item, test.expected) {
+      t.Errorf("validate: got %v, want %v", test.item, test.expected)
+    }
+  }
+
+  valid, rejected := r.Validate([]map[string]interface{}{{"title": "a"}, {"views": int64(1)}, {"title": "c"}}, schema)
+  if items := valid.([]interface{}); len(items) != 2 || len(rejected) != 1 {
+    t.Fatalf("validate list: got valid %v rejected %v", valid, rejected)
+  }
+  if rejected[0].(map[string]interface{})["error"] != "field title is required" {
+    t.Errorf("validate list: got rejected %v", rejected)
+  }
+  valid, rejected = r.Validate(map[string]interface{}{"views": int64(1)}, schema)
+  if valid != nil || len(rejected) != 1 {
+    t.Errorf("validate object: got valid %v rejected %v", valid, rejected)
+  }
+  if _, rejected = r.Validate("text", schema); len(rejected) != 1 {
+    t.Errorf("validate scalar: got rejected %v", rejected)
+  }
+}
+
+func TestSourcesCompileSchema(t *testing.T) {
+  r := &SourcesRepository{}
+  tests := []struct {
+    schema   *ExtractSchema
+    expected []string
+  }{
+    {&ExtractSchema{Invalid: "quarantine", Fields: []*SchemaField{{Name: "tags", Type: "array", Default: "[]"}}}, nil},
+    {&ExtractSchema{Invalid: "keep"}, []string{"schema.invalid: mode keep not supported"}},
+    {&ExtractSchema{Fields: []*SchemaField{{Type: "string"}}}, []string{"schema.fields[0].name: name is required"}},
+    {&ExtractSchema{Fields: []*SchemaField{{Name: "a", Type: "date"}}}, []string{"schema.fields[0].type: type date not supported"}},
+  }
+  for _, test := range tests {
+    if errs := r.CompileSchema("schema", test.schema); !reflect.DeepEqual(errs, test.expected) {
+      t.Errorf("compile schema %+v: got %v, want %v", test.schema, errs, test.expected)
+    }
+  }
+  for _, field := range []*SchemaField{{Name: "a", Type: "int", Default: "x"}, {Name: "a", Type: "object", Default: "{"}} {
+    if errs := r.CompileSchema("schema", &ExtractSchema{Fields: []*SchemaField{field}}); len(errs) != 1 || !strings.HasPrefix(errs[0], "schema.fields[0].default: ") {
+      t.Errorf("compile schema default %+v: got %v", field, errs)
+    }
+  }
+}
+
+func TestSourcesOrder(t *testing.T) {
+  r := &SourcesRepository{}
+  tests := []struct {
+    inputs   map[string]string
+    expected []string
+    err      string
+  }{
+    {map[string]string{"b": "", "a": ""}, []string{"a", "b"}, ""},
+    {map[string]string{"a": "c", "b": "", "c": "b"}, []string{"b", "c", "a"}, ""},
+    {map[string]string{"a": "b", "b": "", "c": "b"}, []string{"b", "a", "c"}, ""},
+    {map[string]string{"a": "x"}, nil, "extract_rules.a.input: rule x not exists"},
+    {map[string]string{"a": "b", "b": "a", "c": ""}, nil, "extract_rules: cycle between rules a, b"},
+    {map[string]string{"a": "a"}, nil, "extract_rules: cycle between rules a"},
+  }
+  for _, test := range tests {
+    extractRules := map[string]*ExtractRules{}
+    for name, input := range test.inputs {
+      extractRules[name] = &ExtractRules{Input: input}
+    }
+    order, err := r.Order(extractRules)
+    if test.err != "" {
+      if err == nil || err.Error() != test.err {
+        t.Errorf("order %v: got %v, want %q", test.inputs, err, test.err)
+      }
+      continue
+    }
+    if err != nil || !reflect.DeepEqual(order, test.expected) {
+      t.Errorf("order %v: got %v %v, want %v", test.inputs, order, err, test.expected)
+    }
+  }
+}
+
+func TestSourcesFilter(t *testing.T) {
+  r := &SourcesRepository{}
+  data := map[string]interface{}{
+    "title":  "Bitcoin hits new high",
+    "views":  float64(120),
+    "price":  " 3.5 ",
+    "tags":   []interface{}{"btc", "market"},
+    "empty":  nil,
+    "author": map[string]interface{}{"name": "alice"},
+  }
+
+  tests := []struct {
+    filter   *Filter
+    expected bool
+  }{
+    {&Filter{Field: "title", Op: "exists"}, true},
+    {&Filter{Field: "empty", Op: "exists"}, false},
+    {&Filter{Field: "missing", Op: "not_exists"}, true},
+    {&Filter{Field: "empty", Op: "not_exists"}, true},
+    {&Filter{Field: "views", Op: "eq", Value: "120"}, true},
+    {&Filter{Field: "views", Op: "ne", Value: "120"}, false},
+    {&Filter{Field: "missing", Op: "ne", Value: "120"}, true},
+    {&Filter{Field: "missing", Op: "eq", Value: ""}, false},
+    {&Filter{Field: "title", Op: "contains", Value: "new high"}, true},
+    {&Filter{Field: "tags", Op: "contains", Value: "btc"}, true},
+    {&Filter{Field: "tags", Op: "contains", Value: "bt"}, false},
+    {&Filter{Field: "tags", Op: "not_contains", Value: "eth"}, true},
+    {&Filter{Field: "missing", Op: "not_contains", Value: "eth"}, true},
+    {&Filter{Field: "title", Op: "regex", Value: "(?i)^bitcoin"}, true},
+    {&Filter{Field: "title", Op: "regex", Value: "["}, false},
+    {&Filter{Field: "views", Op: "gt", Value: "100"}, true},
+    {&Filter{Field: "views", Op: "gte", Value: "120"}, true},
+    {&Filter{Field: "views", Op: "lt", Value: "120"}, false},
+    {&Filter{Field: "price", Op: "lte", Value: "3.5"}, true},
+    {&Filter{Field: "title", Op: "gt", Value: "1"}, false},
+    {&Filter{Field: "author.name", Op: "eq", Value: "alice"}, true},
+    {&Filter{Field: "author.email", Op: "exists"}, false},
+    {&Filter{Field: "views", Op: "between", Value: "1"}, false},
+    {&Filter{All: []*Filter{{Field: "title", Op: "exists"}, {Field: "views", Op: "gt", Value: "100"}}}, true},
+    {&Filter{All: []*Filter{{Field: "title", Op: "exists"}, {Field: "views", Op: "gt", Value: "200"}}}, false},
+    {&Filter{Any: []*Filter{{Field: "views", Op: "gt", Value: "200"}, {Field: "tags", Op: "contains", Value: "market"}}}, true},
+    {&Filter{Any: []*Filter{{Field: "views", Op: "gt", Value: "200"}, {Field: "missing", Op: "exists"}}}, false},
+    {&Filter{Not: &Filter{Field: "title", Op: "contains", Value: "sponsored"}}, true},
+    {&Filter{Not: &Filter{Any: []*Filter{{Field: "tags", Op: "contains", Value: "btc"}}}}, false},
+    {&Filter{Field: "views", Op: "gt", Value: "100", Not: &Filter{Field: "title", Op: "regex", Value: "high$"}}, false},
+  }
+  for _, test := range tests {
+    if result := r.FilterItem(data, test.filter); result != test.expected {
+      t.Errorf("filter %+v: got %v, want %v", test.filter, result, test.expected)
+    }
+  }
+
+  rules := &JsonExtractRules{
+    List:   "items",
+    Fields: []*JsonExtractField{{Name: "id", Path: "id"}, {Name: "type", Path: "type"}},
+    Filters: []*Filter{
+      {Field: "type", Op: "ne", Value: "ad"},
+      {Field: "id", Op: "gte", Value: "2"},
+    },
+  }
+  result, err := r.ExtractJsonList(`{"items":[{"id":1},{"id":2,"type":"ad"},{"id":3,"type":"news"},{"id":4}]}`, rules, nil)
+  expected := []map[string]interface{}{{"id": float64(3), "type": "news"}, {"id": float64(4)}}
+  if err != nil || !reflect.DeepEqual(result, expected) {
+    t.Errorf("filter list: got %v %v, want %v", result, err, expected)
+  }
+}
+
+func TestSourcesCompileFilters(t *testing.T) {
+  r := &SourcesRepository{}
+  tests := []struct {
+    filters  []*Filter
+    expected []string
+  }{
+    {[]*Filter{{Field: "a", Op: "eq"}, {All: []*Filter{{Field: "b", Op: "gt", Value: "1"}}}}, nil},
+    {[]*Filter{{Field: "a", Op: "between"}}, []string{"filters[0].op: op between not supported"}},
+    {[]*Filter{{Op: "exists"}}, []string{"filters[0].field: field is required"}},
+    {[]*Filter{{Field: "a", Op: "gt", Value: "x"}}, []string{`filters[0].value: strconv.ParseFloat: parsing "x": invalid syntax`}},
+    {[]*Filter{{Any: []*Filter{{Field: "a", Op: "eq"}, {Op: "eq"}}}}, []string{"filters[0].any[1].field: field is required"}},
+    {[]*Filter{{Not: &Filter{Field: "a", Op: "like"}}}, []string{"filters[0].not[0].op: op like not supported"}},
+  }
+  for _, test := range tests {
+    if errs := r.CompileFilters("filters", test.filters); !reflect.DeepEqual(errs, test.expected) {
+      t.Errorf("compile filters: got %v, want %v", errs, test.expected)
+    }
+  }
+  if errs := r.CompileFilters("filters", []*Filter{{Field: "a", Op: "regex", Value: "("}}); len(errs) != 1 || !strings.HasPrefix(errs[0], "filters[0].value: ") {
+    t.Errorf("compile filters regex: got %v", errs)
+  }
+}
+
+func TestSourcesCompute(t *testing.T) {
+  r := &SourcesRepository{}
+  page := r.Page("https://example.com/news/index.html", nil)
+  page.Time = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+  tests := []struct {
+    template string
+    expected interface{}
+  }{
+    {"static", "static"},
+    {"{{.title | lower}}", "bitcoin rally"},
+    {"{{upper .title}}", "BITCOIN RALLY"},
+    {"{{trim .summary}}", "up 5%"},
+    {`{{replace "-" " " .slug}}`, "btc price today"},
+    {`{{join ", " .tags}}`, "btc, market"},
+    {`{{join ", " .title}}`, "Bitcoin Rally"},
+    {`{{default "n/a" .author}}`, "n/a"},
+    {`{{default "n/a" .title}}`, "Bitcoin Rally"},
+    {"{{url}}", "https://example.com/news/index.html"},
+    {"{{fetched}}", "2024-05-06T07:08:09Z"},
+    {`{{fetched "2006-01-02"}}`, "2024-05-06"},
+    {"{{resolve .link}}", "https://example.com/news/btc.html"},
+    {"{{.views}} views", "120 views"},
+    {"{{.missing}}", nil},
+    {"{{.title.name}}", nil},
+  }
+  for _, test := range tests {
+    data := map[string]interface{}{
+      "title":   "Bitcoin Rally",
+      "summary": "  up 5% ",
+      "slug":    "btc-price-today",
+      "tags":    []interface{}{"btc", "market"},
+      "author":  "",
+      "link":    "btc.html",
+      "views":   float64(120),
+    }
+    r.Compute(data, []*ComputedField{{Name: "computed", Template: test.template}}, page)
+    if value := data["computed"]; value != test.expected {
+      t.Errorf("compute %s: got %v, want %v", test.template, value, test.expected)
+    }
+  }
+
+  data := map[string]interface{}{"slug": "btc"}
+  r.Compute(data, []*ComputedField{
+    {Name: "path", Template: "/coins/{{.slug}}"},
+    {Name: "link", Template: "{{resolve .path}}"},
+  }, page)
+  if data["link"] != "https://example.com/coins/btc" {
+    t.Errorf("compute chained: got %v", data)
+  }
+
+  if _, err := r.Template("{{.missing}}", map[string]interface{}{}, page); err == nil || !strings.Contains(err.Error(), `map has no entry for key "missing"`) {
+    t.Errorf("template missing key: got %v", err)
+  }
+}
+
+func TestSourcesCompileComputed(t *testing.T) {
+  r := &SourcesRepository{}
+  tests := []struct {
+    computed []*ComputedField
+    expected []string
+  }{
+    {[]*ComputedField{{Name: "a", Template: "{{.title | lower}}"}, {Name: "b", Template: "plain"}}, nil},
+    {[]*ComputedField{{Template: "x"}}, []string{"computed[0].name: name is required"}},
+    {[]*ComputedField{{Name: "a", Template: "{{.title"}}, []string{"computed[0].template: "}},
+    {[]*ComputedField{{Name: "a", Template: "{{shout .title}}"}}, []string{"computed[0].template: "}},
+  }
+  for _, test := range tests {
+    errs := r.CompileComputed("computed", test.computed)
+    if len(errs) != len(test.expected) {
+      t.Errorf("compile computed %v: got %v, want %v", test.computed[0].Template, errs, test.expected)
+      continue
+    }
+    for i := range errs {
+      if !strings.HasPrefix(errs[i], test.expected[i]) {
+        t.Errorf("compile computed %v: got %v, want %v", test.computed[0].Template, errs, test.expected)
+      }
+    }
+  }
+
+  for i := 0; i < TemplatesMax+10; i++ {
+    if _, err := r.ParseTemplate(fmt.Sprintf("{{.id}}-%d", i)); err != nil {
+      t.Fatalf("parse template %d: %v", i, err)
+    }
+  }
+  var size int
+  templates.Range(func(_, _ interface{}) bool {
+    size++
+    return true
+  })
+  if size > TemplatesMax {
+    t.Errorf("templates cache: got %d entries, want at most %d", size, TemplatesMax)
+  }
+}
+
+func TestSourcesArticle(t *testing.T) {
+  r := &SourcesRepository{}
+  paragraph := "Bitcoin climbed past its previous record on Monday, as traders priced in more inflows, lower rates, and a calmer macro backdrop."
+
+  tests := []struct {
+    html        string
+    rules       *ArticleExtractRules
+    expected    map[string]interface{}
+    contains    []string
+    notContains []string
+  }{
+    {
+      `<html><head>
+<meta property="og:title" content="OG Title">
+<meta property="og:image" content="/cover.png">
+<meta name="author" content="Alice">
+<meta property="article:published_time" content="2024-01-02T10:30:00+08:00">
+</head><body>
+<nav><a href="/">Home</a><a href="/markets">Markets</a></nav>
+<article><h1>Body Title</h1>
+<p>` + paragraph + `</p>
+<p>Analysts said the move, which <a href="/data">followed weeks</a> of steady buying, could extend further.</p>
+<script>track()</script>
+<div class="share-tools">Share this story on every network you can find today</div>
+</article>
+<aside class="sidebar"><p>Related: ten other stories you might like, picked for you, updated hourly.</p></aside>
+<footer><p>Copyright Example Media, all rights reserved, since the year two thousand.</p></footer>
+</body></html>`,
+      &ArticleExtractRules{},
+      map[string]interface{}{
+        "title":          "OG Title",
+        "byline":         "Alice",
+        "published_time": "2024-01-02T02:30:00Z",
+        "image":          "https://example.com/cover.png",
+      },
+      []string{paragraph, `href="https://example.com/data"`, "Analysts said the move"},
+      []string{"Home", "track()", "Share this story", "Related:", "Copyright"},
+    },
+    {
+      `<html><head><title>Site</title></head><body>
+<div id="main"><h1>Plain Title</h1><span class="byline">By Bob</span><time datetime="2024-03-04 05:06:07">March 4</time>
+<div class="content"><img src="chart.png"><p>` + paragraph + `</p><p>Second paragraph, with more detail, and more commas, for the scorer.</p></div></div>
+<div id="comments"><p>Great post, thanks, really enjoyed it, will share it with friends.</p></div>
+</body></html>`,
+      &ArticleExtractRules{Output: "text", Computed: []*ComputedField{{Name: "headline", Template: "{{upper .title}}"}}},
+      map[string]interface{}{
+        "title":          "Plain Title",
+        "byline":         "By Bob",
+        "published_time": "2024-03-04T05:06:07Z",
+        "image":          "https://example.com/news/chart.png",
+        "headline":       "PLAIN TITLE",
+      },
+      []string{paragraph, "Second paragraph"},
+      []string{"Great post", "<p>"},
+    },
+    {
+      `<html><body><div class="post"><h2 class="lead">Lead</h2><p>` + paragraph + `</p></div></body></html>`,
+      &ArticleExtractRules{Fields: []*HtmlExtractField{{Name: "title", Node: &HtmlExtractNode{Selector: ".lead"}}}},
+      map[string]interface{}{"title": "Lead"},
+      []string{paragraph},
+      nil,
+    },
+  }
+  for i, test := range tests {
+    doc := newDocument(t, test.html)
+    page := r.Page("https://example.com/news/1.html", doc)
+    page.Location = time.UTC
+    data, err := r.ExtractArticle(doc, test.rules, page)
+    if err != nil {
+      t.Errorf("article %d: %v", i, err)
+      continue
+    }
+    for key, expected := range test.expected {
+      if data[key] != expected {
+        t.Errorf("article %d %s: got %#v, want %#v", i, key, data[key], expected)
+      }
+    }
+    content := fmt.Sprintf("%v\n%v", data["html"], data["text"])
+    for _, text := range test.contains {
+      if !strings.Contains(content, text) {
+        t.Errorf("article %d: missing %q in %s", i, text, content)
+      }
+    }
+    for _, text := range test.notContains {
+      if strings.Contains(content, text) {
+        t.Errorf("article %d: unexpected %q in %s", i, text, content)
+      }
+    }
+  }
+}
@@ -1,23 +1,35 @@
 package repositories
 
 import (
+  "bytes"
+  "context"
   "crypto/sha1"
   "encoding/hex"
   "encoding/json"
   "errors"
   "fmt"
   "io/ioutil"
+  "mime"
   "net"
   "net/http"
   "net/url"
+  "regexp"
+  "strconv"
+  "strings"
   "time"
+  "unicode/utf8"
 
   "github.com/PuerkitoBio/goquery"
+  "github.com/antchfx/xmlquery"
+  "github.com/go-redis/redis/v8"
   "github.com/hibiken/asynq"
   "github.com/nats-io/nats.go"
   "github.com/rs/xid"
+  "github.com/temoto/robotstxt"
   "github.com/tidwall/gjson"
   "gorm.io/datatypes"
+  "golang.org/x/net/html/charset"
+  "golang.org/x/text/encoding"
   "gorm.io/gorm"
 
   "taoniu.local/crawls/spiders/common"
@@ -26,12 +38,22 @@ import (
   "taoniu.local/crawls/spiders/queue/asynq/jobs"
 )
 
+type ExtractOutput struct {
+  Result        map[string]interface{}
+  Rejected      map[string]interface{}
+  RejectedCount int
+  Matches       map[string]interface{}
+}
+
 type TasksRepository struct {
   Db                *gorm.DB
+  Rdb               *redis.Client
   Nats              *nats.Conn
   Asynq             *asynq.Client
   Job               *jobs.Tasks
+  Fetcher           common.Fetcher
   SourcesRepository *SourcesRepository
+  ProxiesRepository *ProxiesRepository
 }
 
 func (r *TasksRepository) Source() *SourcesRepository {
@@ -45,7 +67,10 @@ func (r *TasksRepository) Source() *SourcesRepository {
 
 func (r *TasksRepository) Scan(status int) []string {
   var ids []string
-  r.Db.Model(&models.Task{}).Where("status", 3).Pluck("id", &ids)
+  r.Db.Model(&models.Task{}).Where("status = ? AND next_attempt_at <= ?", status, time.Now()).Pluck("id", &ids)
+  if len(ids) > 0 {
+    r.Db.Model(&models.Task{}).Where("id IN ?", ids).Update("next_attempt_at", time.Now().Add(5*time.Minute))
+  }
   return ids
 }
 
@@ -88,19 +113,28 @@ func (r *TasksRepository) Save(
   result := r.Db.Where("url_sha1 = ? AND url = ?", urlSha1, url).Take(&entity)
   if errors.Is(result.Error, gorm.ErrRecordNotFound) {
     entity = &models.Task{
-      ID:            xid.New().String(),
-      ParentID:      parentId,
-      SourceID:      sourceId,
-      Url:           url,
-      UrlSha1:       urlSha1,
-      ExtractResult: map[string]interface{}{},
+      ID:             xid.New().String(),
+      ParentID:       parentId,
+      SourceID:       sourceId,
+      Url:            url,
+      UrlSha1:        urlSha1,
+      ExtractResult:  map[string]interface{}{},
+      RejectedResult: map[string]interface{}{},
+      MatchResult:    map[string]interface{}{},
     }
     r.Db.Create(&entity)
   } else {
-    entity.ParentID = parentId
-    entity.SourceID = sourceId
-    entity.Status = 0
-    r.Db.Model(&models.Task{ID: entity.ID}).Updates(entity)
+    values := map[string]interface{}{
+      "source_id":       sourceId,
+      "status":          0,
+      "attempts":        0,
+      "last_error":      "",
+      "next_attempt_at": time.Time{},
+    }
+    if parentId != "" {
+      values["parent_id"] = parentId
+    }
+    r.Db.Model(&models.Task{ID: entity.ID}).Updates(values)
   }
 
   job, err := r.Job.Process(entity.ID)
@@ -116,136 +150,772 @@ func (r *TasksRepository) Save(
   return nil
 }
 
-func (r *TasksRepository) Process(task *models.Task) error {
-  tr := &http.Transport{
-    DisableKeepAlives: true,
+const (
+  TaskErrorSize       = 5000
+  RobotsCacheTTL      = 24 * time.Hour
+  RobotsErrorCacheTTL = 5 * time.Minute
+)
+
+var retryPolicies = map[string]*RetryPolicy{
+  "network":      {MaxAttempts: 5, Base: 30 * time.Second, Max: 30 * time.Minute},
+  "timeout":      {MaxAttempts: 5, Base: time.Minute, Max: time.Hour},
+  "rate_limited": {MaxAttempts: 8, Base: time.Minute, Max: 2 * time.Hour},
+  "server":       {MaxAttempts: 5, Base: time.Minute, Max: time.Hour},
+  "client":       {MaxAttempts: 1},
+  "blocked":      {MaxAttempts: 3, Base: 30 * time.Minute, Max: 12 * time.Hour},
+  "extract":      {MaxAttempts: 2, Base: 10 * time.Minute, Max: time.Hour},
+}
+
+type RetryPolicy struct {
+  MaxAttempts int
+  Base        time.Duration
+  Max         time.Duration
+}
+
+func (p *RetryPolicy) Backoff(attempts int) time.Duration {
+  delay := p.Base
+  for i := 1; i < attempts && delay < p.Max; i++ {
+    delay *= 2
+  }
+  if delay > p.Max {
+    delay = p.Max
+  }
+  return delay
+}
+
+type TaskError struct {
+  Class      string
+  Err        error
+  RetryAfter time.Duration
+}
+
+func (e *TaskError) Error() string {
+  return fmt.Sprintf("%s: %v", e.Class, e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+  return e.Err
+}
+
+type RobotsCache struct {
+  Status int    `json:"status"`
+  Body   string `json:"body"`
+}
+
+var (
+  xmlCharsetPattern  = regexp.MustCompile(`^\s*<\?xml[^>]+encoding=["']([\w-]+)["']`)
+  metaCharsetPattern = regexp.MustCompile(`(?i)<meta\s[^>]*charset\s*=\s*["']?\s*([\w-]+)`)
+  sniffCharsets      = []string{"gb18030", "big5", "shift_jis", "euc-kr"}
+  charsetBoms        = []struct {
+    bom   string
+    label string
+  }{
+    {"\xef\xbb\xbf", "utf-8"},
+    {"\xfe\xff", "utf-16be"},
+    {"\xff\xfe", "utf-16le"},
   }
+)
+
+const (
+  CharsetPrescanSize = 4096
+)
 
+func (r *TasksRepository) Process(task *models.Task) error {
   source, err := r.Source().Get(task.SourceID)
   if err != nil {
     return err
   }
 
-  if source.UseProxy {
-    session := &common.ProxySession{
-      Proxy: fmt.Sprintf("socks5://127.0.0.1:1088?timeout=%ds", source.Timeout),
-    }
-    tr.DialContext = session.DialContext
-  } else {
-    session := &net.Dialer{}
-    tr.DialContext = session.DialContext
+  extractRules, err := r.Source().Rules(source)
+  if err != nil {
+    return r.Fail(task, &TaskError{Class: "extract", Err: err})
   }
 
-  httpClient := &http.Client{
-    Transport: tr,
-    Timeout:   time.Duration(source.Timeout) * time.Second,
+  robots, taskErr := r.Robots(source, task.Url)
+  if taskErr != nil {
+    return r.Fail(task, taskErr)
+  }
+  if robots != nil && !robots.Test(r.RobotsPath(task.Url)) {
+    r.Db.Model(&models.Task{ID: task.ID}).Update("status", 4)
+    return nil
   }
 
-  req, _ := http.NewRequest("GET", task.Url, nil)
-  for key, val := range source.Headers {
-    req.Header.Set(key, val.(string))
+  var crawlDelay time.Duration
+  if robots != nil {
+    crawlDelay = robots.CrawlDelay
+  }
+  limiter := r.Limiter(source, task.Url, crawlDelay)
+  if limiter != nil {
+    acquired, wait := limiter.Acquire()
+    if !acquired {
+      return r.Reschedule(task.ID, wait)
+    }
+    defer limiter.Release()
+    time.Sleep(limiter.Jitter())
+  }
+
+  version := r.Source().Version(source)
+  headers := map[string]string{}
+  if task.RulesVersion == version {
+    if task.ETag != "" {
+      headers["If-None-Match"] = task.ETag
+    }
+    if task.LastModified != "" {
+      headers["If-Modified-Since"] = task.LastModified
+    }
   }
-  resp, err := httpClient.Do(req)
+
+  resp, err := r.Request(source, task.Url, headers)
   if err != nil {
-    r.Db.Model(&task).Update("status", 3)
-    return err
+    return r.Fail(task, r.Classify(err))
   }
   defer resp.Body.Close()
 
+  if resp.StatusCode == http.StatusNotModified {
+    r.Db.Model(&models.Task{ID: task.ID}).Updates(map[string]interface{}{
+      "status":          1,
+      "attempts":        0,
+      "last_error":      "",
+      "next_attempt_at": time.Time{},
+    })
+    return nil
+  }
+
   if resp.StatusCode != http.StatusOK {
-    return errors.New(
+    return r.Fail(task, r.ClassifyResponse(resp))
+  }
+
+  body, err := ioutil.ReadAll(resp.Body)
+  if err != nil {
+    return r.Fail(task, r.Classify(err))
+  }
+
+  hash := sha1.New()
+  hash.Write(body)
+  hash.Write([]byte(version))
+  contentHash := hex.EncodeToString(hash.Sum(nil))
+  task.ETag = resp.Header.Get("ETag")
+  task.LastModified = resp.Header.Get("Last-Modified")
+  if contentHash == task.ContentHash {
+    r.Db.Model(&models.Task{ID: task.ID}).Updates(map[string]interface{}{
+      "status":          1,
+      "etag":            task.ETag,
+      "last_modified":   task.LastModified,
+      "attempts":        0,
+      "last_error":      "",
+      "next_attempt_at": time.Time{},
+    })
+    return nil
+  }
+
+  body, err = r.Transcode(source, resp.Header.Get("Content-Type"), body)
+  if err != nil {
+    return r.Fail(task, &TaskError{Class: "extract", Err: err})
+  }
+
+  output, err := r.Extract(source, extractRules, task.Url, body)
+  if err != nil {
+    return r.Fail(task, &TaskError{Class: "extract", Err: err})
+  }
+
+  url, err := r.Scroll(source, task.Url, output.Result)
+  if err == nil && url != "" {
+    r.Save("", source.ID, url)
+  }
+
+  task.Status = 1
+  task.ExtractResult = r.JSONMap(output.Result)
+  task.RejectedCount = output.RejectedCount
+  task.RejectedResult = r.JSONMap(output.Rejected)
+  task.MatchResult = r.JSONMap(output.Matches)
+  task.ContentHash = contentHash
+  task.RulesVersion = version
+  task.Attempts = 0
+  task.LastError = ""
+  task.NextAttemptAt = time.Time{}
+
+  r.Db.Model(&models.Task{ID: task.ID}).Select(
+    "status",
+    "extract_result",
+    "rejected_count",
+    "rejected_result",
+    "match_result",
+    "etag",
+    "last_modified",
+    "content_hash",
+    "rules_version",
+    "attempts",
+    "last_error",
+    "next_attempt_at",
+  ).Updates(task)
+
+  r.Nats.Publish(source.Slug, []byte(task.ID))
+  r.Nats.Flush()
+
+  return nil
+}
+
+func (r *TasksRepository) Fail(task *models.Task, taskErr *TaskError) error {
+  policy, ok := retryPolicies[taskErr.Class]
+  if !ok {
+    policy = retryPolicies["network"]
+  }
+
+  task.Attempts++
+  task.LastError = taskErr.Error()
+  if len(task.LastError) > TaskErrorSize {
+    size := TaskErrorSize
+    for size > 0 && !utf8.RuneStart(task.LastError[size]) {
+      size--
+    }
+    task.LastError = task.LastError[:size]
+  }
+
+  if task.Attempts >= policy.MaxAttempts {
+    task.Status = 5
+    task.NextAttemptAt = time.Time{}
+  } else {
+    delay := policy.Backoff(task.Attempts)
+    if taskErr.RetryAfter > delay {
+      delay = taskErr.RetryAfter
+    }
+    task.Status = 3
+    task.NextAttemptAt = time.Now().Add(delay)
+  }
+
+  r.Db.Model(&models.Task{ID: task.ID}).Select(
+    "status",
+    "attempts",
+    "last_error",
+    "next_attempt_at",
+  ).Updates(task)
+
+  return taskErr
+}
+
+func (r *TasksRepository) Classify(err error) *TaskError {
+  var netErr net.Error
+  if errors.As(err, &netErr) && netErr.Timeout() {
+    return &TaskError{Class: "timeout", Err: err}
+  }
+  return &TaskError{Class: "network", Err: err}
+}
+
+func (r *TasksRepository) ClassifyResponse(resp *http.Response) *TaskError {
+  taskErr := &TaskError{
+    Err: errors.New(
       fmt.Sprintf(
         "request error: status[%s] code[%d]",
         resp.Status,
         resp.StatusCode,
       ),
-    )
+    ),
+  }
+  switch {
+  case resp.StatusCode == http.StatusRequestTimeout:
+    taskErr.Class = "timeout"
+  case resp.StatusCode == http.StatusTooManyRequests:
+    taskErr.Class = "rate_limited"
+  case resp.StatusCode == http.StatusForbidden, resp.StatusCode == http.StatusUnavailableForLegalReasons:
+    taskErr.Class = "blocked"
+  case resp.StatusCode >= http.StatusInternalServerError:
+    taskErr.Class = "server"
+  default:
+    taskErr.Class = "client"
+  }
+  taskErr.RetryAfter = r.RetryAfter(resp.Header.Get("Retry-After"))
+  return taskErr
+}
+
+func (r *TasksRepository) RetryAfter(value string) time.Duration {
+  value = strings.TrimSpace(value)
+  if value == "" {
+    return 0
+  }
+  if seconds, err := strconv.Atoi(value); err == nil {
+    return time.Duration(seconds) * time.Second
+  }
+  if datetime, err := http.ParseTime(value); err == nil {
+    return time.Until(datetime)
+  }
+  return 0
+}
+
+func (r *TasksRepository) Limiter(source *models.Source, taskUrl string, crawlDelay time.Duration) *common.HostLimiter {
+  if r.Rdb == nil {
+    return nil
+  }
+  address, err := url.Parse(taskUrl)
+  if err != nil || address.Hostname() == "" {
+    return nil
+  }
+
+  limit := common.DefaultRateLimit()
+  if value, ok := source.Params["limit"]; ok {
+    buf, _ := json.Marshal(value)
+    json.Unmarshal(buf, &limit)
+  }
+  if crawlDelay > 0 {
+    rate := float64(time.Second) / float64(crawlDelay)
+    if limit.Rate <= 0 || limit.Rate > rate {
+      limit.Rate = rate
+    }
+  }
+  if limit.Rate <= 0 && limit.Concurrency <= 0 && limit.Jitter <= 0 {
+    return nil
+  }
+
+  timeout := time.Duration(source.Timeout)*time.Second + time.Minute
+  return common.NewHostLimiter(r.Rdb, context.Background(), address.Hostname(), limit, timeout)
+}
+
+// Robots fails closed: an unreachable or 5xx robots.txt is returned as a
+// retryable error, and 5xx answers are only cached for RobotsErrorCacheTTL.
+func (r *TasksRepository) Robots(source *models.Source, taskUrl string) (*robotstxt.Group, *TaskError) {
+  if enabled, ok := source.Params["robots"].(bool); ok && !enabled {
+    return nil, nil
+  }
+  address, err := url.Parse(taskUrl)
+  if err != nil || address.Host == "" {
+    return nil, nil
+  }
+  robotsUrl := fmt.Sprintf("%s://%s/robots.txt", address.Scheme, address.Host)
+
+  var cache *RobotsCache
+  key := fmt.Sprintf("caches:spiders:robots:%s", robotsUrl)
+  if r.Rdb != nil {
+    if buf, err := r.Rdb.Get(context.Background(), key).Bytes(); err == nil {
+      json.Unmarshal(buf, &cache)
+    }
+  }
+  if cache == nil {
+    request := *source
+    request.Method = http.MethodGet
+    request.Body = ""
+    resp, err := r.Request(&request, robotsUrl, nil)
+    if err != nil {
+      return nil, r.Classify(err)
+    }
+    defer resp.Body.Close()
+    body, err := ioutil.ReadAll(resp.Body)
+    if err != nil {
+      return nil, r.Classify(err)
+    }
+    cache = &RobotsCache{
+      Status: resp.StatusCode,
+      Body:   string(body),
+    }
+    if r.Rdb != nil {
+      ttl := RobotsCacheTTL
+      if cache.Status >= http.StatusInternalServerError {
+        ttl = RobotsErrorCacheTTL
+      }
+      buf, _ := json.Marshal(cache)
+      r.Rdb.Set(context.Background(), key, buf, ttl)
+    }
+  }
+
+  if cache.Status >= http.StatusInternalServerError {
+    return nil, &TaskError{
+      Class: "server",
+      Err:   errors.New(fmt.Sprintf("robots error: code[%d]", cache.Status)),
+    }
+  }
+
+  robots, err := robotstxt.FromStatusAndString(cache.Status, cache.Body)
+  if err != nil {
+    return nil, nil
+  }
+  agent := "*"
+  if value, ok := source.Headers["User-Agent"].(string); ok && value != "" {
+    agent = value
+  }
+  return robots.FindGroup(agent), nil
+}
+
+func (r *TasksRepository) RobotsPath(taskUrl string) string {
+  address, err := url.Parse(taskUrl)
+  if err != nil {
+    return "/"
+  }
+  return address.RequestURI()
+}
+
+func (r *TasksRepository) Reschedule(id string, delay time.Duration) error {
+  job, err := r.Job.Process(id)
+  if err != nil {
+    return err
+  }
+  _, err = r.Asynq.Enqueue(
+    job,
+    asynq.Queue(config.TASKS),
+    asynq.MaxRetry(0),
+    asynq.Timeout(5*time.Minute),
+    asynq.ProcessIn(delay),
+  )
+  return err
+}
+
+func (r *TasksRepository) Request(source *models.Source, url string, headers map[string]string) (*http.Response, error) {
+  request, proxy, err := r.FetchRequest(source, url, headers)
+  if err != nil {
+    return nil, err
+  }
+
+  start := time.Now()
+  resp, err := r.Fetch().Fetch(request)
+  if err == nil && resp.StatusCode == http.StatusProxyAuthRequired {
+    r.Proxies().Report(proxy, time.Since(start), errors.New(resp.Status))
+  } else {
+    r.Proxies().Report(proxy, time.Since(start), err)
+  }
+  return resp, err
+}
+
+func (r *TasksRepository) FetchRequest(source *models.Source, url string, headers map[string]string) (*common.FetchRequest, *models.Proxy, error) {
+  request := &common.FetchRequest{
+    Method:      source.Method,
+    Url:         url,
+    Body:        r.RequestBody(source, url),
+    ContentType: source.ContentType,
+    Headers:     map[string]string{},
+    Timeout:     source.Timeout,
+  }
+  for key, val := range source.Headers {
+    request.Headers[key] = val.(string)
+  }
+  for key, val := range headers {
+    request.Headers[key] = val
+  }
+  if request.Body != "" && request.ContentType == "" {
+    request.ContentType = "application/x-www-form-urlencoded"
+    if body := strings.TrimSpace(request.Body); strings.HasPrefix(body, "{") || strings.HasPrefix(body, "[") {
+      request.ContentType = "application/json"
+    }
+  }
+
+  proxy, err := r.Proxies().Pick(source, url)
+  if err != nil {
+    return nil, nil, err
+  }
+  if proxy != nil {
+    request.Proxy = r.Proxies().Url(proxy, source.Timeout)
+  }
+  return request, proxy, nil
+}
+
+func (r *TasksRepository) Proxies() *ProxiesRepository {
+  if r.ProxiesRepository == nil {
+    r.ProxiesRepository = &ProxiesRepository{
+      Db: r.Db,
+    }
+  }
+  return r.ProxiesRepository
+}
+
+func (r *TasksRepository) Fetch() common.Fetcher {
+  if r.Fetcher == nil {
+    r.Fetcher = &common.HttpFetcher{}
+  }
+  return r.Fetcher
+}
+
+func (r *TasksRepository) RequestBody(source *models.Source, taskUrl string) string {
+  body := source.Body
+  if body == "" {
+    return body
+  }
+  url, err := url.Parse(taskUrl)
+  if err != nil {
+    return body
+  }
+  values := url.Query()
+  if items, ok := source.Params["query"].([]interface{}); ok {
+    for _, item := range items {
+      item := item.(map[string]interface{})
+      name := item["name"].(string)
+      value := item["value"].(string)
+      if value == "$0" || value == "$1" {
+        body = strings.ReplaceAll(body, value, values.Get(name))
+      }
+    }
+  }
+  return body
+}
+
+func (r *TasksRepository) Transcode(source *models.Source, contentType string, body []byte) ([]byte, error) {
+  e, name := r.Charset(source, contentType, body)
+  if e == nil || name == "utf-8" {
+    return bytes.TrimPrefix(body, []byte("\xef\xbb\xbf")), nil
+  }
+  result, err := e.NewDecoder().Bytes(body)
+  if err != nil {
+    return nil, errors.New(fmt.Sprintf("transcode %s error: %v", name, err))
+  }
+  result = bytes.TrimPrefix(result, []byte("\xef\xbb\xbf"))
+  if loc := xmlCharsetPattern.FindSubmatchIndex(result); loc != nil {
+    result = append(append(append([]byte{}, result[:loc[2]]...), "UTF-8"...), result[loc[3]:]...)
+  }
+  return result, nil
+}
+
+func (r *TasksRepository) Charset(source *models.Source, contentType string, body []byte) (encoding.Encoding, string) {
+  if label, _ := source.Params["charset"].(string); label != "" {
+    if e, name := charset.Lookup(label); e != nil {
+      return e, name
+    }
+  }
+
+  if _, params, err := mime.ParseMediaType(contentType); err == nil {
+    if e, name := charset.Lookup(params["charset"]); e != nil {
+      return e, name
+    }
+  }
+
+  for _, item := range charsetBoms {
+    if bytes.HasPrefix(body, []byte(item.bom)) {
+      return charset.Lookup(item.label)
+    }
+  }
+
+  if e, name := r.Prescan(body); e != nil {
+    return e, name
+  }
+
+  if utf8.Valid(body) {
+    return nil, "utf-8"
+  }
+
+  var best encoding.Encoding
+  var bestName string
+  bestInvalid := -1
+  for _, label := range sniffCharsets {
+    e, name := charset.Lookup(label)
+    text, err := e.NewDecoder().Bytes(body)
+    if err != nil {
+      continue
+    }
+    invalid := strings.Count(string(text), "\ufffd")
+    if bestInvalid == -1 || invalid < bestInvalid {
+      best, bestName, bestInvalid = e, name, invalid
+    }
+  }
+  if best != nil {
+    return best, bestName
+  }
+
+  return charset.Lookup("windows-1252")
+}
+
+func (r *TasksRepository) Prescan(body []byte) (encoding.Encoding, string) {
+  if len(body) > CharsetPrescanSize {
+    body = body[:CharsetPrescanSize]
+  }
+  matches := xmlCharsetPattern.FindSubmatch(body)
+  if matches == nil {
+    matches = metaCharsetPattern.FindSubmatch(body)
+  }
+  if matches == nil {
+    return nil, ""
+  }
+  e, name := charset.Lookup(string(matches[1]))
+  if strings.HasPrefix(name, "utf-16") {
+    return charset.Lookup("utf-8")
+  }
+  return e, name
+}
+
+func (r *TasksRepository) Extract(source *models.Source, extractRules map[string]*ExtractRules, url string, body []byte) (*ExtractOutput, error) {
+  order, err := r.Source().Order(extractRules)
+  if err != nil {
+    return nil, err
   }
 
-  var content string
   var doc *goquery.Document
+  var feed *xmlquery.Node
 
-  result := make(map[string]interface{})
-  for key, value := range source.ExtractRules {
-    rules := r.Source().ToExtractRules(value)
-    if rules.Html != nil {
-      if doc == nil {
-        doc, err = goquery.NewDocumentFromReader(resp.Body)
-        if err != nil {
-          return err
-        }
+  output := &ExtractOutput{
+    Result:   make(map[string]interface{}),
+    Rejected: make(map[string]interface{}),
+    Matches:  make(map[string]interface{}),
+  }
+
+  location := r.Source().Location(source)
+  page := r.Source().Page(url, nil)
+  page.Location = location
+  page.Matches = output.Matches
+  outputs := make(map[string]interface{})
+  var errs []string
+  var pending, extracted int
+  for _, key := range order {
+    rules := extractRules[key]
+    page.Rule = key
+    if rules.Input == "" {
+      pending++
+    }
+
+    content := body
+    if rules.Input != "" {
+      value, ok := outputs[rules.Input]
+      if !ok {
+        continue
       }
-      if rules.Html.List != nil {
-        result[key], err = r.Source().ExtractHtmlList(doc, rules.Html)
-      } else {
-        result[key], err = r.Source().ExtractHtml(doc, rules.Html)
+      content = r.Source().Content(value)
+    }
+    if len(content) == 0 {
+      if rules.Input == "" {
+        return nil, errors.New("content is empty")
       }
+      continue
     }
-    if rules.Json != nil {
-      if _, ok := result[key]; ok {
-        content = result[key].(string)
-      } else {
-        if content == "" {
-          body, _ := ioutil.ReadAll(resp.Body)
-          content = string(body)
-          if content == "" {
-            return errors.New("content is empty")
+
+    var value interface{}
+    if rules.Html != nil || rules.Script != nil || rules.Article != nil || rules.Metadata != nil {
+      var d *goquery.Document
+      p := page
+      if rules.Input == "" {
+        if doc == nil {
+          doc, err = goquery.NewDocumentFromReader(bytes.NewReader(body))
+          if err != nil {
+            return nil, err
           }
+          page = r.Source().Page(url, doc)
+          page.Location = location
+          page.Rule = key
+          page.Matches = output.Matches
         }
-      }
-      if rules.Json.List != "" {
-        result[key], err = r.Source().ExtractJsonList(content, rules.Json)
+        d = doc
+        p = page
+      } else {
+        d, err = goquery.NewDocumentFromReader(bytes.NewReader(content))
         if err != nil {
           continue
         }
+        fragment := *page
+        fragment.Metadata = nil
+        p = &fragment
+      }
+      if rules.Html != nil {
+        if rules.Html.List != nil {
+          value, err = r.Source().ExtractHtmlList(d, rules.Html, p)
+        } else {
+          value, err = r.Source().ExtractHtml(d, rules.Html, p)
+        }
+      } else if rules.Script != nil {
+        value, err = r.Source().ExtractScript(d, rules.Script, p)
+      } else if rules.Article != nil {
+        value, err = r.Source().ExtractArticle(d, rules.Article, p)
+      } else {
+        value, err = r.Source().ExtractMetadata(d, rules.Metadata, p)
+      }
+    } else if rules.Xml != nil {
+      var f *xmlquery.Node
+      if rules.Input == "" {
+        if feed == nil {
+          feed, err = xmlquery.Parse(bytes.NewReader(body))
+          if err != nil {
+            return nil, err
+          }
+        }
+        f = feed
       } else {
-        result[key], err = r.Source().ExtractJson(content, rules.Json)
+        f, err = xmlquery.Parse(bytes.NewReader(content))
         if err != nil {
           continue
         }
       }
+      if rules.Xml.List != "" || rules.Xml.Preset != "" {
+        value, err = r.Source().ExtractXmlList(f, rules.Xml, page)
+      } else {
+        value, err = r.Source().ExtractXml(f, rules.Xml, page)
+      }
+    } else if rules.Json != nil {
+      if rules.Json.List != "" {
+        value, err = r.Source().ExtractJsonList(string(content), rules.Json, page)
+      } else {
+        value, err = r.Source().ExtractJson(string(content), rules.Json, page)
+      }
+    }
+    if err != nil {
+      errs = append(errs, fmt.Sprintf("%s: %v", key, err))
+      continue
+    }
+    if value == nil {
+      continue
     }
-  }
 
-  if scroll, ok := source.Params["scroll"]; ok {
-    content, err := json.Marshal(result)
-    if err == nil {
-      items := gjson.GetBytes(content, scroll.(string))
-      if items.Exists() {
-        items := items.Array()
-        score := items[len(items)-1]
-        url, err := url.Parse(task.Url)
-        if err == nil {
-          values := url.Query()
-          if items, ok := source.Params["query"].([]interface{}); ok {
-            for _, item := range items {
-              item := item.(map[string]interface{})
-              name := item["name"].(string)
-              value := item["value"].(string)
-              if value == "$0" {
-                continue
-              }
-              if value == "$1" {
-                value = fmt.Sprintf("%v", score)
-              }
-              values[name] = []string{value}
-            }
-          }
-          url.RawQuery = values.Encode()
-          r.Save("", source.ID, url.String())
-        }
+    if rules.Schema != nil {
+      var items []interface{}
+      value, items = r.Source().Validate(value, rules.Schema)
+      output.RejectedCount += len(items)
+      if len(items) > 0 && rules.Schema.Invalid == "quarantine" {
+        output.Rejected[key] = items
+      }
+      if value == nil {
+        continue
       }
     }
+
+    outputs[key] = value
+    if !rules.Internal {
+      output.Result[key] = value
+    }
+    if rules.Input == "" && !r.Source().SchemaEmpty(value) {
+      extracted++
+    }
   }
-  task.Status = 1
-  task.ExtractResult = r.JSONMap(result)
 
-  r.Db.Model(&models.Task{ID: task.ID}).Updates(task)
+  if pending > 0 && extracted == 0 {
+    if len(errs) > 0 {
+      return nil, errors.New(fmt.Sprintf("extract error: %s", strings.Join(errs, "; ")))
+    }
+    return nil, errors.New("extract error: no rules matched")
+  }
 
-  r.Nats.Publish(source.Slug, []byte(task.ID))
-  r.Nats.Flush()
+  return output, nil
+}
 
-  return nil
+func (r *TasksRepository) Scroll(source *models.Source, taskUrl string, result map[string]interface{}) (string, error) {
+  scroll, ok := source.Params["scroll"]
+  if !ok {
+    return "", nil
+  }
+
+  content, err := json.Marshal(result)
+  if err != nil {
+    return "", err
+  }
+  items := gjson.GetBytes(content, scroll.(string))
+  if !items.Exists() {
+    return "", nil
+  }
+  scores := items.Array()
+  if len(scores) == 0 {
+    return "", nil
+  }
+  score := scores[len(scores)-1]
+
+  url, err := url.Parse(taskUrl)
+  if err != nil {
+    return "", err
+  }
+  values := url.Query()
+  if items, ok := source.Params["query"].([]interface{}); ok {
+    for _, item := range items {
+      item := item.(map[string]interface{})
+      name := item["name"].(string)
+      value := item["value"].(string)
+      if value == "$0" {
+        continue
+      }
+      if value == "$1" {
+        value = fmt.Sprintf("%v", score)
+      }
+      values[name] = []string{value}
+    }
+  }
+  url.RawQuery = values.Encode()
+  return url.String(), nil
 }
 
 func (r *TasksRepository) JSONMap(in interface{}) datatypes.JSONMap {
@@ -0,0 +1,932 @@
+package repositories
+
+import (
+  "context"
+  "errors"
+  "io/ioutil"
+  "net"
+  "net/http"
+  "reflect"
+  "strings"
+  "testing"
+  "time"
+  "unicode/utf8"
+
+  "github.com/alicebob/miniredis/v2"
+  "github.com/go-redis/redis/v8"
+  "github.com/hibiken/asynq"
+  "github.com/rs/xid"
+  "golang.org/x/text/encoding"
+  "golang.org/x/text/encoding/simplifiedchinese"
+  "golang.org/x/text/encoding/traditionalchinese"
+  "golang.org/x/text/encoding/unicode"
+  "gorm.io/datatypes"
+  "gorm.io/driver/sqlite"
+  "gorm.io/gorm"
+  "gorm.io/gorm/logger"
+
+  "taoniu.local/crawls/spiders/common"
+  "taoniu.local/crawls/spiders/models"
+  "taoniu.local/crawls/spiders/queue/asynq/jobs"
+)
+
+type fakeFetcher struct {
+  requests []*common.FetchRequest
+  robots   func(request *common.FetchRequest) (*http.Response, error)
+  handle   func(request *common.FetchRequest) *http.Response
+}
+
+func (f *fakeFetcher) Fetch(request *common.FetchRequest) (*http.Response, error) {
+  f.requests = append(f.requests, request)
+  if strings.HasSuffix(request.Url, "/robots.txt") {
+    if f.robots != nil {
+      return f.robots(request)
+    }
+    return newResponse(http.StatusNotFound, nil, ""), nil
+  }
+  return f.handle(request), nil
+}
+
+func (f *fakeFetcher) pages() (requests []*common.FetchRequest) {
+  for _, request := range f.requests {
+    if !strings.HasSuffix(request.Url, "/robots.txt") {
+      requests = append(requests, request)
+    }
+  }
+  return
+}
+
+func newResponse(status int, headers map[string]string, body string) *http.Response {
+  resp := &http.Response{
+    StatusCode: status,
+    Status:     http.StatusText(status),
+    Header:     http.Header{},
+    Body:       ioutil.NopCloser(strings.NewReader(body)),
+  }
+  for key, val := range headers {
+    resp.Header.Set(key, val)
+  }
+  return resp
+}
+
+func newTasksRepository(t *testing.T, fetcher common.Fetcher) *TasksRepository {
+  db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+    Logger: logger.Default.LogMode(logger.Silent),
+  })
+  if err != nil {
+    t.Fatalf("open db: %v", err)
+  }
+  conn, _ := db.DB()
+  conn.SetMaxOpenConns(1)
+  if err := db.AutoMigrate(&models.Source{}, &models.Task{}, &models.Proxy{}); err != nil {
+    t.Fatalf("migrate db: %v", err)
+  }
+  return &TasksRepository{
+    Db:      db,
+    Fetcher: fetcher,
+  }
+}
+
+func newSource(t *testing.T, r *TasksRepository, source *models.Source) *models.Source {
+  source.ID = xid.New().String()
+  source.Slug = source.ID
+  if source.Headers == nil {
+    source.Headers = map[string]interface{}{}
+  }
+  if source.Params == nil {
+    source.Params = map[string]interface{}{}
+  }
+  if err := r.Db.Create(source).Error; err != nil {
+    t.Fatalf("create source: %v", err)
+  }
+  return source
+}
+
+func newTask(t *testing.T, r *TasksRepository, task *models.Task) *models.Task {
+  task.ID = xid.New().String()
+  task.ExtractResult = map[string]interface{}{}
+  task.RejectedResult = map[string]interface{}{}
+  task.MatchResult = map[string]interface{}{}
+  if err := r.Db.Create(task).Error; err != nil {
+    t.Fatalf("create task: %v", err)
+  }
+  return task
+}
+
+func findTask(t *testing.T, r *TasksRepository, id string) *models.Task {
+  var task *models.Task
+  if err := r.Db.First(&task, "id", id).Error; err != nil {
+    t.Fatalf("find task: %v", err)
+  }
+  return task
+}
+
+func TestTasksProcessNotModified(t *testing.T) {
+  fetcher := &fakeFetcher{
+    handle: func(request *common.FetchRequest) *http.Response {
+      if request.Headers["If-None-Match"] == `"v1"` && request.Headers["If-Modified-Since"] == "Tue, 02 Jan 2024 02:30:00 GMT" {
+        return newResponse(http.StatusNotModified, nil, "")
+      }
+      return newResponse(http.StatusOK, nil, "<html><body><h1>changed</h1></body></html>")
+    },
+  }
+  r := newTasksRepository(t, fetcher)
+  source := newSource(t, r, &models.Source{
+    Url: "https://example.com/news/{}.html",
+    ExtractRules: map[string]interface{}{
+      "detail": map[string]interface{}{
+        "html": map[string]interface{}{
+          "container": map[string]interface{}{"selector": "body"},
+          "fields": []interface{}{
+            map[string]interface{}{"name": "title", "node": map[string]interface{}{"selector": "h1"}},
+          },
+        },
+      },
+    },
+  })
+  task := newTask(t, r, &models.Task{
+    SourceID:     source.ID,
+    Url:          "https://example.com/news/1.html",
+    ETag:         `"v1"`,
+    LastModified: "Tue, 02 Jan 2024 02:30:00 GMT",
+    ContentHash:  "previous",
+    RulesVersion: r.Source().Version(source),
+    Attempts:     2,
+    Status:       3,
+  })
+
+  if err := r.Process(task); err != nil {
+    t.Fatalf("process: %v", err)
+  }
+  if requests := fetcher.pages(); len(requests) != 1 || requests[0].Url != task.Url {
+    t.Fatalf("process: unexpected requests %#v", requests)
+  }
+  task = findTask(t, r, task.ID)
+  if task.Status != 1 || task.Attempts != 0 || task.ContentHash != "previous" || len(task.ExtractResult) != 0 {
+    t.Errorf("process not modified: got status %d attempts %d hash %q result %v", task.Status, task.Attempts, task.ContentHash, task.ExtractResult)
+  }
+
+  source.ExtractRules["detail"].(map[string]interface{})["html"].(map[string]interface{})["fields"] = []interface{}{
+    map[string]interface{}{"name": "headline", "node": map[string]interface{}{"selector": "h1"}},
+  }
+  r.Db.Model(&models.Source{ID: source.ID}).Update("extract_rules", source.ExtractRules)
+  r.Db.Model(&models.Task{ID: task.ID}).Update("status", 0)
+  if err := r.Process(findTask(t, r, task.ID)); err != nil {
+    t.Fatalf("process after rules update: %v", err)
+  }
+  requests := fetcher.pages()
+  if request := requests[len(requests)-1]; request.Headers["If-None-Match"] != "" {
+    t.Errorf("process after rules update: conditional headers sent %v", request.Headers)
+  }
+  task = findTask(t, r, task.ID)
+  detail, _ := task.ExtractResult["detail"].(map[string]interface{})
+  if task.Status != 1 || detail["headline"] != "changed" {
+    t.Errorf("process after rules update: got status %d result %v", task.Status, task.ExtractResult)
+  }
+}
+
+func TestTasksProcessUnchanged(t *testing.T) {
+  body := "<html><body><h1>first</h1></body></html>"
+  fetcher := &fakeFetcher{
+    handle: func(request *common.FetchRequest) *http.Response {
+      return newResponse(http.StatusOK, nil, body)
+    },
+  }
+  r := newTasksRepository(t, fetcher)
+  rules := datatypes.JSONMap{
+    "detail": map[string]interface{}{
+      "html": map[string]interface{}{
+        "container": map[string]interface{}{"selector": "body"},
+        "fields": []interface{}{
+          map[string]interface{}{"name": "title", "node": map[string]interface{}{"selector": "h1"}},
+        },
+      },
+    },
+  }
+  source := newSource(t, r, &models.Source{
+    Url:          "https://example.com/news/{}.html",
+    ExtractRules: rules,
+  })
+  task := newTask(t, r, &models.Task{
+    SourceID: source.ID,
+    Url:      "https://example.com/news/1.html",
+  })
+
+  process := func(name string) map[string]interface{} {
+    if err := r.Process(findTask(t, r, task.ID)); err != nil {
+      t.Fatalf("process %s: %v", name, err)
+    }
+    task := findTask(t, r, task.ID)
+    if task.Status != 1 {
+      t.Fatalf("process %s: got status %d", name, task.Status)
+    }
+    detail, _ := task.ExtractResult["detail"].(map[string]interface{})
+    return detail
+  }
+  stale := func() {
+    r.Db.Model(&models.Task{ID: task.ID}).Update("extract_result", datatypes.JSONMap{
+      "detail": map[string]interface{}{"title": "stale"},
+    })
+  }
+
+  if detail := process("first"); detail["title"] != "first" {
+    t.Fatalf("process first: got %v", detail)
+  }
+
+  stale()
+  if detail := process("unchanged"); detail["title"] != "stale" {
+    t.Errorf("process unchanged: content re-extracted %v", detail)
+  }
+
+  body = "<html><body><h1>second</h1></body></html>"
+  if detail := process("changed"); detail["title"] != "second" {
+    t.Errorf("process changed: got %v", detail)
+  }
+
+  stale()
+  rules["detail"].(map[string]interface{})["html"].(map[string]interface{})["fields"] = []interface{}{
+    map[string]interface{}{"name": "headline", "node": map[string]interface{}{"selector": "h1"}},
+  }
+  r.Db.Model(&models.Source{ID: source.ID}).Update("extract_rules", rules)
+  if detail := process("rules changed"); detail["headline"] != "second" {
+    t.Errorf("process rules changed: got %v", detail)
+  }
+}
+
+func TestTasksProcessPostBody(t *testing.T) {
+  fetcher := &fakeFetcher{
+    handle: func(request *common.FetchRequest) *http.Response {
+      return newResponse(http.StatusOK, map[string]string{"Content-Type": "application/json"}, `{"data":[{"id":1,"title":"One"},{"id":2,"title":"Two"}]}`)
+    },
+  }
+  r := newTasksRepository(t, fetcher)
+  source := newSource(t, r, &models.Source{
+    Url:    "https://example.com/api/news?page={}",
+    Method: http.MethodPost,
+    Body:   `{"page":$0,"size":20}`,
+    Headers: map[string]interface{}{
+      "User-Agent": "spiders",
+    },
+    Params: map[string]interface{}{
+      "query": []interface{}{
+        map[string]interface{}{"name": "page", "value": "$0"},
+      },
+    },
+    ExtractRules: map[string]interface{}{
+      "news": map[string]interface{}{
+        "json": map[string]interface{}{
+          "list": "data",
+          "fields": []interface{}{
+            map[string]interface{}{"name": "id", "path": "id"},
+            map[string]interface{}{"name": "title", "path": "title"},
+          },
+        },
+      },
+    },
+  })
+  task := newTask(t, r, &models.Task{
+    SourceID: source.ID,
+    Url:      "https://example.com/api/news?page=3",
+  })
+
+  if err := r.Process(task); err != nil {
+    t.Fatalf("process: %v", err)
+  }
+  requests := fetcher.pages()
+  if len(requests) != 1 {
+    t.Fatalf("process: got %d requests, want 1", len(requests))
+  }
+  request := requests[0]
+  if request.Method != http.MethodPost || request.Body != `{"page":3,"size":20}` || request.ContentType != "application/json" || request.Headers["User-Agent"] != "spiders" {
+    t.Errorf("process request: got %s %q %q %v", request.Method, request.Body, request.ContentType, request.Headers)
+  }
+
+  task = findTask(t, r, task.ID)
+  items, _ := task.ExtractResult["news"].([]interface{})
+  if task.Status != 1 || len(items) != 2 || task.ContentHash == "" {
+    t.Errorf("process result: got status %d result %v hash %q", task.Status, task.ExtractResult, task.ContentHash)
+  }
+}
+
+func TestTasksProcessBlockPage(t *testing.T) {
+  fetcher := &fakeFetcher{
+    handle: func(request *common.FetchRequest) *http.Response {
+      return newResponse(http.StatusOK, nil, `<html><body><form id="captcha"><p>Verify you are human</p></form></body></html>`)
+    },
+  }
+  r := newTasksRepository(t, fetcher)
+  source := newSource(t, r, &models.Source{
+    Url: "https://example.com/news/{}.html",
+    ExtractRules: map[string]interface{}{
+      "news": map[string]interface{}{
+        "html": map[string]interface{}{
+          "container": map[string]interface{}{"selector": ".news"},
+          "list":      map[string]interface{}{"selector": "li"},
+          "fields": []interface{}{
+            map[string]interface{}{"name": "title", "node": map[string]interface{}{"selector": "a"}},
+          },
+        },
+      },
+      "detail": map[string]interface{}{
+        "html": map[string]interface{}{
+          "container": map[string]interface{}{"selector": "article"},
+          "fields": []interface{}{
+            map[string]interface{}{"name": "title", "node": map[string]interface{}{"selector": "h1"}},
+          },
+        },
+      },
+    },
+  })
+  task := newTask(t, r, &models.Task{
+    SourceID: source.ID,
+    Url:      "https://example.com/news/1.html",
+  })
+
+  err := r.Process(task)
+  var taskErr *TaskError
+  if !errors.As(err, &taskErr) || taskErr.Class != "extract" {
+    t.Fatalf("process block page: got %v, want extract error", err)
+  }
+  task = findTask(t, r, task.ID)
+  if task.Status != 3 || task.ContentHash != "" || len(task.ExtractResult) != 0 || !strings.HasPrefix(task.LastError, "extract: ") {
+    t.Errorf("process block page: got status %d hash %q result %v error %q", task.Status, task.ContentHash, task.ExtractResult, task.LastError)
+  }
+}
+
+func TestTasksExtract(t *testing.T) {
+  r := &TasksRepository{}
+  source := &models.Source{Params: map[string]interface{}{}}
+  body := []byte(`<html><body><article><h1>Bitcoin</h1></article></body></html>`)
+  detail := &ExtractRules{Html: &HtmlExtractRules{
+    Container: &HtmlExtractNode{Selector: "article"},
+    Fields:    []*HtmlExtractField{{Name: "title", Node: &HtmlExtractNode{Selector: "h1"}}},
+  }}
+  empty := &ExtractRules{Html: &HtmlExtractRules{
+    Container: &HtmlExtractNode{Selector: "article"},
+    List:      &HtmlExtractNode{Selector: "li"},
+    Fields:    []*HtmlExtractField{{Name: "title", Node: &HtmlExtractNode{Selector: "a"}}},
+  }}
+  missing := &ExtractRules{Html: &HtmlExtractRules{
+    Container: &HtmlExtractNode{Selector: ".news"},
+    List:      &HtmlExtractNode{Selector: "li"},
+    Fields:    []*HtmlExtractField{{Name: "title", Node: &HtmlExtractNode{Selector: "a"}}},
+  }}
+  broken := &ExtractRules{Json: &JsonExtractRules{
+    Container: "data",
+    List:      "items",
+    Fields:    []*JsonExtractField{{Name: "title", Path: "title"}},
+  }}
+
+  tests := []struct {
+    name  string
+    rules map[string]*ExtractRules
+    errs  []string
+  }{
+    {"partial", map[string]*ExtractRules{"detail": detail, "news": missing}, nil},
+    {"empty", map[string]*ExtractRules{"news": empty}, []string{"extract error: no rules matched"}},
+    {"errors", map[string]*ExtractRules{"news": missing, "api": broken}, []string{"extract error: ", "news: container not exists", "api: container not exists"}},
+  }
+  for _, test := range tests {
+    output, err := r.Extract(source, test.rules, "https://example.com/news/1.html", body)
+    if test.errs == nil {
+      if err != nil || output.Result["detail"] == nil {
+        t.Errorf("extract %s: got %v %v", test.name, output, err)
+      }
+      continue
+    }
+    if err == nil {
+      t.Errorf("extract %s: got %v, want error", test.name, output.Result)
+      continue
+    }
+    for _, text := range test.errs {
+      if !strings.Contains(err.Error(), text) {
+        t.Errorf("extract %s: got %v, want %q", test.name, err, text)
+      }
+    }
+  }
+}
+
+func TestTasksProcessReschedule(t *testing.T) {
+  mr := miniredis.RunT(t)
+  fetcher := &fakeFetcher{
+    handle: func(request *common.FetchRequest) *http.Response {
+      return newResponse(http.StatusOK, nil, "<html><body><h1>title</h1></body></html>")
+    },
+  }
+  r := newTasksRepository(t, fetcher)
+  r.Rdb = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+  r.Asynq = asynq.NewClient(asynq.RedisClientOpt{Addr: mr.Addr()})
+  r.Job = &jobs.Tasks{}
+  defer r.Rdb.Close()
+  defer r.Asynq.Close()
+
+  source := newSource(t, r, &models.Source{
+    Url: "https://example.com/news/{}.html",
+    Params: map[string]interface{}{
+      "limit": map[string]interface{}{"concurrency": 1},
+    },
+    ExtractRules: map[string]interface{}{
+      "detail": map[string]interface{}{
+        "html": map[string]interface{}{
+          "container": map[string]interface{}{"selector": "body"},
+          "fields": []interface{}{
+            map[string]interface{}{"name": "title", "node": map[string]interface{}{"selector": "h1"}},
+          },
+        },
+      },
+    },
+  })
+  task := newTask(t, r, &models.Task{
+    SourceID: source.ID,
+    Url:      "https://example.com/news/1.html",
+  })
+
+  busy := common.NewHostLimiter(r.Rdb, context.Background(), "example.com", &common.RateLimit{Concurrency: 1}, time.Minute)
+  if acquired, _ := busy.Acquire(); !acquired {
+    t.Fatal("acquire: not acquired")
+  }
+  start := time.Now()
+  if err := r.Process(task); err != nil {
+    t.Fatalf("process limited: %v", err)
+  }
+  if requests := fetcher.pages(); len(requests) != 0 {
+    t.Errorf("process limited: got %d page requests, want 0", len(requests))
+  }
+  scheduled, err := mr.ZMembers("asynq:{spiders.jobs.tasks}:scheduled")
+  if err != nil || len(scheduled) != 1 {
+    t.Fatalf("process limited: got scheduled %v %v, want 1 task", scheduled, err)
+  }
+  score, _ := mr.ZScore("asynq:{spiders.jobs.tasks}:scheduled", scheduled[0])
+  if at := time.Unix(int64(score), 0); at.Before(start.Truncate(time.Second)) || at.After(start.Add(2*time.Second)) {
+    t.Errorf("process limited: scheduled at %v, want about %v", at, start.Add(time.Second))
+  }
+  if task = findTask(t, r, task.ID); task.Status != 0 || task.Attempts != 0 {
+    t.Errorf("process limited: got status %d attempts %d", task.Status, task.Attempts)
+  }
+
+  busy.Release()
+  if err := r.Process(task); err != nil {
+    t.Fatalf("process released: %v", err)
+  }
+  if task = findTask(t, r, task.ID); task.Status != 1 {
+    t.Errorf("process released: got status %d, want 1", task.Status)
+  }
+  if inflight, _ := mr.ZMembers("limits:spiders:hosts:example.com:inflight"); len(inflight) != 0 {
+    t.Errorf("process released: lease not released %v", inflight)
+  }
+}
+
+func TestTasksExtractSchema(t *testing.T) {
+  r := &TasksRepository{}
+  source := &models.Source{Params: map[string]interface{}{}}
+  body := []byte(`<html><body><ul><li><a href="/1">One</a><em>3</em></li><li><em>4</em></li><li><a href="/3">Three</a><em>x</em></li></ul></body></html>`)
+  rules := func(invalid string) map[string]*ExtractRules {
+    return map[string]*ExtractRules{
+      "news": {
+        Html: &HtmlExtractRules{
+          Container: &HtmlExtractNode{Selector: "ul"},
+          List:      &HtmlExtractNode{Selector: "li"},
+          Fields: []*HtmlExtractField{
+            {Name: "title", Node: &HtmlExtractNode{Selector: "a"}},
+            {Name: "views", Node: &HtmlExtractNode{Selector: "em"}, Transforms: []*Transform{{Type: "int"}}},
+          },
+        },
+        Schema: &ExtractSchema{
+          Invalid: invalid,
+          Fields: []*SchemaField{
+            {Name: "title", Type: "string", Required: true},
+            {Name: "views", Type: "int", Required: true},
+          },
+        },
+      },
+    }
+  }
+
+  tests := []struct {
+    invalid  string
+    rejected int
+  }{
+    {"", 0},
+    {"drop", 0},
+    {"quarantine", 2},
+  }
+  for _, test := range tests {
+    output, err := r.Extract(source, rules(test.invalid), "https://example.com/news", body)
+    if err != nil {
+      t.Fatalf("extract schema %q: %v", test.invalid, err)
+    }
+    items, _ := output.Result["news"].([]interface{})
+    if len(items) != 1 || items[0].(map[string]interface{})["title"] != "One" || output.RejectedCount != 2 {
+      t.Errorf("extract schema %q: got result %v count %d", test.invalid, output.Result, output.RejectedCount)
+    }
+    rejected, _ := output.Rejected["news"].([]interface{})
+    if len(rejected) != test.rejected {
+      t.Errorf("extract schema %q: got rejected %v", test.invalid, output.Rejected)
+    }
+  }
+}
+
+func TestTasksExtractInput(t *testing.T) {
+  r := &TasksRepository{}
+  source := &models.Source{Params: map[string]interface{}{}}
+  body := []byte(`{"data":{"items":[{"id":1,"name":"a"},{"id":2,"name":"b"}],"total":2}}`)
+  extractRules := map[string]*ExtractRules{
+    "state": {
+      Internal: true,
+      Json: &JsonExtractRules{
+        Container: "data",
+        Fields: []*JsonExtractField{
+          {Name: "items", Path: "items"},
+          {Name: "total", Path: "total"},
+        },
+      },
+    },
+    "names": {
+      Input: "state",
+      Json: &JsonExtractRules{
+        List:   "items",
+        Fields: []*JsonExtractField{{Name: "name", Path: "name"}},
+      },
+    },
+    "first": {
+      Input: "names",
+      Json:  &JsonExtractRules{Fields: []*JsonExtractField{{Name: "name", Path: "0.name"}}},
+    },
+    "broken": {
+      Json: &JsonExtractRules{Container: "missing", Fields: []*JsonExtractField{{Name: "id", Path: "id"}}},
+    },
+    "orphan": {
+      Input: "broken",
+      Json:  &JsonExtractRules{Fields: []*JsonExtractField{{Name: "id", Path: "id"}}},
+    },
+  }
+
+  output, err := r.Extract(source, extractRules, "https://example.com/api", body)
+  if err != nil {
+    t.Fatalf("extract input: %v", err)
+  }
+  expected := map[string]interface{}{
+    "names": []map[string]interface{}{{"name": "a"}, {"name": "b"}},
+    "first": map[string]interface{}{"name": "a"},
+  }
+  if !reflect.DeepEqual(output.Result, expected) {
+    t.Errorf("extract input: got %v, want %v", output.Result, expected)
+  }
+
+  extractRules["state"].Input = "first"
+  if _, err := r.Extract(source, extractRules, "https://example.com/api", body); err == nil || !strings.Contains(err.Error(), "cycle") {
+    t.Errorf("extract cycle: got %v", err)
+  }
+}
+
+func TestTasksExtractMatches(t *testing.T) {
+  r := &TasksRepository{}
+  source := &models.Source{Params: map[string]interface{}{}}
+  body := []byte(`<html><body><article><h2>Old</h2><ul><li><span>a</span></li><li><span>b</span></li></ul></article></body></html>`)
+  extractRules := map[string]*ExtractRules{
+    "detail": {
+      Html: &HtmlExtractRules{
+        Container: &HtmlExtractNode{Selector: "main", Fallbacks: []string{"article"}},
+        Fields:    []*HtmlExtractField{{Name: "title", Node: &HtmlExtractNode{Selector: "h1", Fallbacks: []string{"h2"}}}},
+      },
+    },
+    "items": {
+      Html: &HtmlExtractRules{
+        Container: &HtmlExtractNode{Selector: "ul"},
+        List:      &HtmlExtractNode{Selector: "li"},
+        Fields:    []*HtmlExtractField{{Name: "name", Node: &HtmlExtractNode{Selector: "a", Fallbacks: []string{"span"}}}},
+      },
+    },
+    "plain": {
+      Html: &HtmlExtractRules{
+        Container: &HtmlExtractNode{Selector: "body"},
+        Fields:    []*HtmlExtractField{{Name: "title", Node: &HtmlExtractNode{Selector: "h2", Fallbacks: []string{"h1"}}}},
+      },
+    },
+  }
+
+  output, err := r.Extract(source, extractRules, "https://example.com/news", body)
+  if err != nil {
+    t.Fatalf("extract matches: %v", err)
+  }
+  expected := map[string]interface{}{
+    "detail": map[string]interface{}{"main": "article", "h1": "h2"},
+    "items":  map[string]interface{}{"a": "span"},
+  }
+  if !reflect.DeepEqual(output.Matches, expected) {
+    t.Errorf("extract matches: got %v, want %v", output.Matches, expected)
+  }
+}
+
+func encode(t *testing.T, e encoding.Encoding, text string) []byte {
+  result, err := e.NewEncoder().Bytes([]byte(text))
+  if err != nil {
+    t.Fatalf("encode %q: %v", text, err)
+  }
+  return result
+}
+
+func TestTasksTranscode(t *testing.T) {
+  r := &TasksRepository{}
+  source := &models.Source{Params: map[string]interface{}{}}
+  text := "<html><head><title>比特幣行情</title></head><body><p>比特幣價格上漲，以太坊跟漲。</p></body></html>"
+  meta := "<html><head><meta charset=\"big5\"><title>比特幣行情</title></head><body><p>比特幣價格上漲，以太坊跟漲。</p></body></html>"
+  pragma := "<html><head><meta http-equiv=\"Content-Type\" content=\"text/html; charset=gbk\"><title>比特币</title></head><body><p>比特币价格上涨</p></body></html>"
+
+  tests := []struct {
+    name        string
+    params      map[string]interface{}
+    contentType string
+    body        []byte
+    charset     string
+    expected    string
+  }{
+    {"utf-8", nil, "", []byte(text), "utf-8", text},
+    {"header", nil, "text/html; charset=GBK", encode(t, simplifiedchinese.GBK, "比特币价格"), "gbk", "比特币价格"},
+    {"header over meta", nil, "text/html; charset=big5", encode(t, traditionalchinese.Big5, strings.Replace(meta, "big5", "gbk", 1)), "big5", strings.Replace(meta, "big5", "gbk", 1)},
+    {"params over header", map[string]interface{}{"charset": "gb18030"}, "text/html; charset=big5", encode(t, simplifiedchinese.GB18030, "比特币"), "gb18030", "比特币"},
+    {"bom utf-8", nil, "", append([]byte("\xef\xbb\xbf"), text...), "utf-8", text},
+    {"bom utf-16le", nil, "", encode(t, unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), text), "utf-16le", text},
+    {"bom utf-16be", nil, "", encode(t, unicode.UTF16(unicode.BigEndian, unicode.UseBOM), text), "utf-16be", text},
+    {"meta charset", nil, "text/html", encode(t, traditionalchinese.Big5, meta), "big5", meta},
+    {"meta pragma", nil, "", encode(t, simplifiedchinese.GBK, pragma), "gbk", pragma},
+    {"xml declaration", nil, "application/xml", encode(t, simplifiedchinese.GBK, `<?xml version="1.0" encoding="GBK"?><rss><title>比特币</title></rss>`), "gbk", `<?xml version="1.0" encoding="UTF-8"?><rss><title>比特币</title></rss>`},
+    {"sniff gb18030", nil, "text/html", encode(t, simplifiedchinese.GB18030, "<p>比特币价格上涨，以太坊跟涨，市场情绪回暖。</p>"), "gb18030", "<p>比特币价格上涨，以太坊跟涨，市场情绪回暖。</p>"},
+  }
+  for _, test := range tests {
+    source.Params = test.params
+    _, name := r.Charset(source, test.contentType, test.body)
+    if name != test.charset {
+      t.Errorf("%s: charset %q, want %q", test.name, name, test.charset)
+    }
+    if test.expected == "" {
+      continue
+    }
+    result, err := r.Transcode(source, test.contentType, test.body)
+    if err != nil {
+      t.Errorf("%s: transcode: %v", test.name, err)
+      continue
+    }
+    if string(result) != test.expected {
+      t.Errorf("%s: got %q, want %q", test.name, result, test.expected)
+    }
+  }
+}
+
+func TestTasksRobots(t *testing.T) {
+  robots := "User-agent: *\nDisallow: /private/\nAllow: /private/public\nCrawl-delay: 2\n\nUser-agent: spiders\nDisallow: /\n"
+  fetcher := &fakeFetcher{
+    robots: func(request *common.FetchRequest) (*http.Response, error) {
+      return newResponse(http.StatusOK, nil, robots), nil
+    },
+  }
+  r := newTasksRepository(t, fetcher)
+  source := &models.Source{Params: map[string]interface{}{}, Headers: map[string]interface{}{}}
+
+  group, taskErr := r.Robots(source, "https://example.com/news/1.html")
+  if taskErr != nil || group == nil {
+    t.Fatalf("robots: got %v %v", group, taskErr)
+  }
+  tests := []struct {
+    url     string
+    allowed bool
+  }{
+    {"https://example.com/news/1.html", true},
+    {"https://example.com/private/1.html", false},
+    {"https://example.com/private/public?id=1", true},
+  }
+  for _, test := range tests {
+    if allowed := group.Test(r.RobotsPath(test.url)); allowed != test.allowed {
+      t.Errorf("robots %s: got %v, want %v", test.url, allowed, test.allowed)
+    }
+  }
+  if group.CrawlDelay != 2*time.Second {
+    t.Errorf("robots crawl delay: got %v, want 2s", group.CrawlDelay)
+  }
+
+  source.Headers["User-Agent"] = "spiders"
+  if group, _ = r.Robots(source, "https://example.com/news/1.html"); group.Test("/news/1.html") {
+    t.Error("robots user agent group: expected disallow")
+  }
+
+  source.Params["robots"] = false
+  if group, taskErr = r.Robots(source, "https://example.com/private/1.html"); group != nil || taskErr != nil {
+    t.Errorf("robots disabled: got %v %v", group, taskErr)
+  }
+
+  for status, allowed := range map[int]bool{http.StatusNotFound: true, http.StatusForbidden: true} {
+    fetcher.robots = func(request *common.FetchRequest) (*http.Response, error) {
+      return newResponse(status, nil, ""), nil
+    }
+    group, taskErr = r.Robots(&models.Source{}, "https://example.com/private/1.html")
+    if taskErr != nil || group.Test("/private/1.html") != allowed {
+      t.Errorf("robots status %d: got %v %v", status, group, taskErr)
+    }
+  }
+
+  fetcher.robots = func(request *common.FetchRequest) (*http.Response, error) {
+    return newResponse(http.StatusServiceUnavailable, nil, ""), nil
+  }
+  if _, taskErr = r.Robots(&models.Source{}, "https://example.com/1.html"); taskErr == nil || taskErr.Class != "server" {
+    t.Errorf("robots 5xx: got %v", taskErr)
+  }
+
+  fetcher.robots = func(request *common.FetchRequest) (*http.Response, error) {
+    return nil, errors.New("connection refused")
+  }
+  if _, taskErr = r.Robots(&models.Source{}, "https://example.com/1.html"); taskErr == nil || taskErr.Class != "network" {
+    t.Errorf("robots fetch error: got %v", taskErr)
+  }
+}
+
+func TestTasksProcessRobots(t *testing.T) {
+  status := http.StatusOK
+  fetcher := &fakeFetcher{
+    robots: func(request *common.FetchRequest) (*http.Response, error) {
+      return newResponse(status, nil, "User-agent: *\nDisallow: /private/\n"), nil
+    },
+    handle: func(request *common.FetchRequest) *http.Response {
+      return newResponse(http.StatusOK, nil, "<html><body><h1>x</h1></body></html>")
+    },
+  }
+  r := newTasksRepository(t, fetcher)
+  source := newSource(t, r, &models.Source{
+    ExtractRules: map[string]interface{}{
+      "detail": map[string]interface{}{
+        "html": map[string]interface{}{
+          "container": map[string]interface{}{"selector": "body"},
+          "fields": []interface{}{
+            map[string]interface{}{"name": "title", "node": map[string]interface{}{"selector": "h1"}},
+          },
+        },
+      },
+    },
+  })
+
+  task := newTask(t, r, &models.Task{SourceID: source.ID, Url: "https://example.com/private/1.html"})
+  if err := r.Process(task); err != nil {
+    t.Fatalf("process disallowed: %v", err)
+  }
+  if task = findTask(t, r, task.ID); task.Status != 4 || len(fetcher.pages()) != 0 {
+    t.Errorf("process disallowed: got status %d requests %d", task.Status, len(fetcher.pages()))
+  }
+
+  status = http.StatusBadGateway
+  task = newTask(t, r, &models.Task{SourceID: source.ID, Url: "https://example.com/news/1.html"})
+  if err := r.Process(task); err == nil {
+    t.Fatal("process robots 5xx: expected error")
+  }
+  task = findTask(t, r, task.ID)
+  if task.Status != 3 || task.Attempts != 1 || !strings.HasPrefix(task.LastError, "server: ") || !task.NextAttemptAt.After(time.Now()) {
+    t.Errorf("process robots 5xx: got status %d attempts %d error %q next %v", task.Status, task.Attempts, task.LastError, task.NextAttemptAt)
+  }
+  if len(fetcher.pages()) != 0 {
+    t.Errorf("process robots 5xx: page fetched")
+  }
+}
+
+func TestTasksBackoff(t *testing.T) {
+  tests := []struct {
+    class    string
+    attempts int
+    expected time.Duration
+  }{
+    {"network", 1, 30 * time.Second},
+    {"network", 2, time.Minute},
+    {"network", 4, 4 * time.Minute},
+    {"network", 7, 30 * time.Minute},
+    {"network", 60, 30 * time.Minute},
+    {"timeout", 3, 4 * time.Minute},
+    {"rate_limited", 8, 2 * time.Hour},
+    {"server", 2, 2 * time.Minute},
+    {"blocked", 2, time.Hour},
+    {"blocked", 6, 12 * time.Hour},
+    {"extract", 1, 10 * time.Minute},
+  }
+  for _, test := range tests {
+    if delay := retryPolicies[test.class].Backoff(test.attempts); delay != test.expected {
+      t.Errorf("backoff %s %d: got %v, want %v", test.class, test.attempts, delay, test.expected)
+    }
+  }
+}
+
+func TestTasksClassify(t *testing.T) {
+  r := &TasksRepository{}
+  tests := []struct {
+    status     int
+    retryAfter string
+    class      string
+    delay      time.Duration
+  }{
+    {http.StatusNotFound, "", "client", 0},
+    {http.StatusRequestTimeout, "", "timeout", 0},
+    {http.StatusGone, "", "client", 0},
+    {http.StatusForbidden, "", "blocked", 0},
+    {http.StatusUnavailableForLegalReasons, "", "blocked", 0},
+    {http.StatusTooManyRequests, "120", "rate_limited", 2 * time.Minute},
+    {http.StatusInternalServerError, "", "server", 0},
+    {http.StatusServiceUnavailable, "30", "server", 30 * time.Second},
+  }
+  for _, test := range tests {
+    resp := newResponse(test.status, map[string]string{"Retry-After": test.retryAfter}, "")
+    taskErr := r.ClassifyResponse(resp)
+    if taskErr.Class != test.class || taskErr.RetryAfter != test.delay {
+      t.Errorf("classify %d: got %s %v, want %s %v", test.status, taskErr.Class, taskErr.RetryAfter, test.class, test.delay)
+    }
+  }
+
+  date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+  if delay := r.RetryAfter(date); delay < 59*time.Minute || delay > time.Hour {
+    t.Errorf("retry after date: got %v", delay)
+  }
+  for _, value := range []string{"", "soon", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)} {
+    if delay := r.RetryAfter(value); delay > 0 {
+      t.Errorf("retry after %q: got %v", value, delay)
+    }
+  }
+
+  if taskErr := r.Classify(errors.New("connection refused")); taskErr.Class != "network" {
+    t.Errorf("classify error: got %s", taskErr.Class)
+  }
+  if taskErr := r.Classify(&net.DNSError{Err: "timeout", IsTimeout: true}); taskErr.Class != "timeout" {
+    t.Errorf("classify timeout: got %s", taskErr.Class)
+  }
+}
+
+func TestTasksFail(t *testing.T) {
+  r := newTasksRepository(t, nil)
+  task := newTask(t, r, &models.Task{Url: "https://example.com/1.html"})
+
+  for attempt := 1; attempt < retryPolicies["server"].MaxAttempts; attempt++ {
+    start := time.Now()
+    r.Fail(task, &TaskError{Class: "server", Err: errors.New("bad gateway")})
+    task = findTask(t, r, task.ID)
+    delay := retryPolicies["server"].Backoff(attempt)
+    if task.Status != 3 || task.Attempts != attempt || task.LastError != "server: bad gateway" || task.NextAttemptAt.Before(start.Add(delay)) {
+      t.Fatalf("fail attempt %d: got status %d attempts %d error %q next %v", attempt, task.Status, task.Attempts, task.LastError, task.NextAttemptAt)
+    }
+  }
+  r.Fail(task, &TaskError{Class: "server", Err: errors.New("bad gateway")})
+  if task = findTask(t, r, task.ID); task.Status != 5 {
+    t.Errorf("fail max attempts: got status %d, want 5", task.Status)
+  }
+
+  task = newTask(t, r, &models.Task{Url: "https://example.com/2.html"})
+  r.Fail(task, &TaskError{Class: "rate_limited", Err: errors.New("slow down"), RetryAfter: 3 * time.Hour})
+  if task = findTask(t, r, task.ID); task.NextAttemptAt.Before(time.Now().Add(3*time.Hour - time.Minute)) {
+    t.Errorf("fail retry after: got next %v", task.NextAttemptAt)
+  }
+
+  task = newTask(t, r, &models.Task{Url: "https://example.com/3.html"})
+  r.Fail(task, &TaskError{Class: "client", Err: errors.New("not found")})
+  if task = findTask(t, r, task.ID); task.Status != 5 {
+    t.Errorf("fail client: got status %d, want 5", task.Status)
+  }
+
+  task = newTask(t, r, &models.Task{Url: "https://example.com/4.html"})
+  r.Fail(task, &TaskError{Class: "extract", Err: errors.New(strings.Repeat("错", 3000))})
+  if len(task.LastError) > TaskErrorSize || !utf8.ValidString(task.LastError) || !strings.HasSuffix(task.LastError, "错") {
+    t.Errorf("fail truncate: got %d bytes valid %v", len(task.LastError), utf8.ValidString(task.LastError))
+  }
+}
+
+func TestTasksSaveResets(t *testing.T) {
+  r := newTasksRepository(t, nil)
+  r.Job = &jobs.Tasks{}
+  r.Asynq = asynq.NewClient(asynq.RedisClientOpt{Addr: "127.0.0.1:1"})
+  defer r.Asynq.Close()
+
+  url := "https://example.com/1.html"
+  r.Save("parent", "source", url)
+  var task *models.Task
+  if err := r.Db.Take(&task, "url", url).Error; err != nil {
+    t.Fatalf("save: %v", err)
+  }
+  r.Db.Model(&models.Task{ID: task.ID}).Updates(map[string]interface{}{
+    "status":          5,
+    "attempts":        5,
+    "last_error":      "server: bad gateway",
+    "next_attempt_at": time.Now().Add(time.Hour),
+  })
+
+  r.Save("", "source", url)
+  task = findTask(t, r, task.ID)
+  if task.Status != 0 || task.Attempts != 0 || task.LastError != "" || !task.NextAttemptAt.IsZero() || task.ParentID != "parent" {
+    t.Errorf("save resubmit: got status %d attempts %d error %q next %v parent %q", task.Status, task.Attempts, task.LastError, task.NextAttemptAt, task.ParentID)
+  }
+}
+
+func TestTasksScan(t *testing.T) {
+  r := newTasksRepository(t, nil)
+  due := newTask(t, r, &models.Task{Url: "https://example.com/1.html", Status: 3, NextAttemptAt: time.Now().Add(-time.Minute)})
+  newTask(t, r, &models.Task{Url: "https://example.com/2.html", Status: 3, NextAttemptAt: time.Now().Add(time.Hour)})
+  newTask(t, r, &models.Task{Url: "https://example.com/3.html", Status: 5})
+
+  ids := r.Scan(3)
+  if len(ids) != 1 || ids[0] != due.ID {
+    t.Fatalf("scan: got %v, want [%s]", ids, due.ID)
+  }
+  if ids = r.Scan(3); len(ids) != 0 {
+    t.Errorf("scan leased: got %v, want none", ids)
+  }
+}